@@ -13,6 +13,8 @@ Transformations
 	- utm_to_wgs84: transform UTM coordinates to WGS84 coordinates
 	- mgrs_usng_to_wgs84: transform MGRS and USNG coordinates to WGS84 coordinates
 	- wgs84_to_mgrs: transform WGS84 coordinates to MGRS coordinates
+	- parse_coordinate: recognises coordinates typed or pasted in any supported notation (reads stdin)
+	- utm_to_dktm: transform UTM coordinates to the Danish DKTM zones and back to UTM
 
 */
 package main
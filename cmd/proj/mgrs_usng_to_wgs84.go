@@ -59,9 +59,9 @@ func FromMgrsToLL(record []string) {
 	//fixme foranstillede nuller for east og north
 	//to build usng location
 	//milGrid := location.Utm.ToMGRS(1)
-	mgrsLL, _, _ := location.Mgrs.ToLL()
+	mgrsLL, _ := location.Mgrs.ToLL()
 	//usng := location.Utm.ToUSNG(1)
-	usngLL, _, _ := location.Usng.ToLL()
+	usngLL, _ := location.Usng.ToLL()
 
 	/**
 	 * Check if milGrid og usng conversion to Wgs84 is the same
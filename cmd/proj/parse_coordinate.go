@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	_ "github.com/brundtoe/go-geografi/pkg/dk"
+	_ "github.com/brundtoe/go-geografi/pkg/dkn"
+	_ "github.com/brundtoe/go-geografi/pkg/eea"
+	_ "github.com/brundtoe/go-geografi/pkg/gars"
+	_ "github.com/brundtoe/go-geografi/pkg/geohash"
+	_ "github.com/brundtoe/go-geografi/pkg/georef"
+	_ "github.com/brundtoe/go-geografi/pkg/maidenhead"
+	_ "github.com/brundtoe/go-geografi/pkg/nordic"
+	_ "github.com/brundtoe/go-geografi/pkg/olc"
+	_ "github.com/brundtoe/go-geografi/pkg/osgrid"
+	"github.com/brundtoe/go-geografi/pkg/proj"
+	_ "github.com/brundtoe/go-geografi/pkg/webmercator"
+)
+
+func main() {
+
+	fmt.Println("Indtast koordinater i vilkårligt format, en pr. linje (afslut med Ctrl-D)")
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		text := strings.TrimSpace(scanner.Text())
+		if text != "" {
+			ParseCoordinate(text)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ParseCoordinate recognises the coordinate and displays it in the supported systems
+func ParseCoordinate(text string) {
+
+	parsed, err := proj.Parse(text)
+	if err != nil {
+		fmt.Printf("%s: %v\n", text, err)
+		return
+	}
+	fmt.Printf("%s: %s (sikkerhed %.1f)\n", text, parsed.System, parsed.Confidence)
+	for _, system := range proj.Systems() {
+		c, err := proj.ConvertTo(parsed.Coordinate, system)
+		if err != nil {
+			fmt.Printf("\t%-9s %v\n", system, err)
+			continue
+		}
+		fmt.Printf("\t%-9s %s\n", system, c)
+	}
+	for _, alternative := range parsed.Alternatives {
+		fmt.Printf("\talternativ: %s %s (sikkerhed %.1f)\n", alternative.System, alternative.Coordinate, alternative.Confidence)
+	}
+}
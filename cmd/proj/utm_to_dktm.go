@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/dk"
+	"github.com/brundtoe/go-geografi/pkg/proj"
+	"github.com/brundtoe/go-geografi/pkg/utils"
+)
+
+func main() {
+
+	filename := "geografi/cities.csv"
+	fp, err := utils.OpenDataFile(filename)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() {
+		fmt.Printf("Datafilen %s lukkes", filename)
+		if err = fp.Close(); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	r := csv.NewReader(fp)
+	r.Comma = ';'
+
+	i := 0
+	fmt.Println("Konverterer UTM til DKTM og tilbage til UTM")
+	fmt.Printf("%-18s %-25s %-28s %-25s\n", "City", "City UTM", "DKTM", "Transformed UTM")
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		// The first line contains field names
+
+		if record[1] != "City" {
+			FromUtmToDktm(record)
+			fmt.Print(".")
+			i += 1
+		}
+	}
+	fmt.Printf("\nAntal linjer behandlet: %d\n", i)
+}
+
+// FromUtmToDktm converts UTM to DKTM in the zone of the city and back again
+/*
+The resulting UTM should equal the city.UTM location.
+*/
+func FromUtmToDktm(record []string) {
+	location := proj.City{}
+	location.BuildCity(record)
+
+	dktm, err := dk.DKTMFromUTM(location.Utm, dk.DKTMZone(location.Geoloc))
+	if err != nil {
+		fmt.Printf("\n%-18s %25s %v\n", location.Name, location.Utm, err)
+		return
+	}
+	ll, err := dktm.ToLL()
+	if err != nil {
+		fmt.Printf("\n%-18s %25s %v\n", location.Name, location.Utm, err)
+		return
+	}
+	utm, err := ll.ToUTMZone(location.Utm.ZoneNumber, proj.North)
+	if err != nil {
+		fmt.Printf("\n%-18s %25s %v\n", location.Name, location.Utm, err)
+		return
+	}
+
+	if strings.Compare(location.Utm.String(), utm.String()) != 0 {
+		fmt.Printf("\n%-18s %25s %28s %25s", location.Name, location.Utm, dktm, utm)
+		fmt.Println(" Transformed UTM differs")
+	}
+}
@@ -0,0 +1,175 @@
+package angle
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Angle defines an angle in radians
+type Angle float64
+
+// FromDegrees returns the angle of deg degrees
+func FromDegrees(deg float64) Angle {
+	return Angle(deg / 180.0 * math.Pi)
+}
+
+// FromRadians returns the angle of rad radians
+func FromRadians(rad float64) Angle {
+	return Angle(rad)
+}
+
+// FromMils returns the angle of mils NATO mils
+func FromMils(mils float64) Angle {
+	return FromUnit(mils, Mil)
+}
+
+// FromWarsawMils returns the angle of mils Warsaw Pact mils
+func FromWarsawMils(mils float64) Angle {
+	return FromUnit(mils, WarsawMil)
+}
+
+// FromGon returns the angle of gon gon
+func FromGon(gon float64) Angle {
+	return FromUnit(gon, Gon)
+}
+
+// FromUnit returns the angle of value in the given unit
+func FromUnit(value float64, unit Unit) Angle {
+	return Angle(value / unit.PerTurn() * 2 * math.Pi)
+}
+
+// Degrees returns the angle in degrees
+func (a Angle) Degrees() float64 {
+	return float64(a) / math.Pi * 180.0
+}
+
+// Radians returns the angle in radians
+func (a Angle) Radians() float64 {
+	return float64(a)
+}
+
+// Mils returns the angle in NATO mils
+func (a Angle) Mils() float64 {
+	return a.In(Mil)
+}
+
+// WarsawMils returns the angle in Warsaw Pact mils
+func (a Angle) WarsawMils() float64 {
+	return a.In(WarsawMil)
+}
+
+// Gon returns the angle in gon
+func (a Angle) Gon() float64 {
+	return a.In(Gon)
+}
+
+// In returns the angle in the given unit
+func (a Angle) In(unit Unit) float64 {
+	return float64(a) / (2 * math.Pi) * unit.PerTurn()
+}
+
+/*
+Normalize returns the angle as a bearing in the range [0, 2π).
+
+	FromDegrees(-90).Normalize().Degrees() // 270
+*/
+func (a Angle) Normalize() Angle {
+	n := math.Mod(float64(a), 2*math.Pi)
+	if n < 0 {
+		n += 2 * math.Pi
+	}
+	return Angle(n)
+}
+
+/*
+String returns the stringified angle in degrees.
+
+	For a bearing of a quarter turn: "90.000000°"
+*/
+func (a Angle) String() string {
+	return a.Format(Degree, 6)
+}
+
+/*
+Format returns the angle in the given unit with decimals decimals followed by the unit symbol.
+
+	FromDegrees(90).Format(Mil, 0) // "1600 mil"
+*/
+func (a Angle) Format(unit Unit, decimals int) string {
+	value := strconv.FormatFloat(a.In(unit), 'f', decimals, 64)
+	if unit == Degree {
+		return value + unit.Symbol()
+	}
+	return value + " " + unit.Symbol()
+}
+
+/*
+Parse parses an angle from text.
+
+The value is followed by an optional unit symbol, see [Unit.Symbol] and [Unit] for the accepted aliases.
+A value without unit is in degrees. Degrees may also be given as degrees, minutes and seconds.
+A comma is accepted as decimal separator.
+
+	"90", "90°", "90.5 deg", "1.5708 rad", "1600 mil", "1500 wpmil", "100 gon", "56°22'30\""
+*/
+func Parse(s string) (Angle, error) {
+
+	text := strings.TrimSpace(s)
+	if text == "" {
+		return 0, fmt.Errorf("invalid empty angle string")
+	}
+
+	if strings.ContainsAny(text, "'\"′″") {
+		return parseDMS(text)
+	}
+
+	// split value and unit symbol
+	i := 0
+	for i < len(text) && strings.ContainsRune("+-0123456789.,eE", rune(text[i])) {
+		i++
+	}
+	value, err := strconv.ParseFloat(strings.Replace(text[:i], ",", ".", 1), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid angle, angle = %s", s)
+	}
+
+	unit, err := ParseUnit(text[i:])
+	if err != nil {
+		return 0, fmt.Errorf("invalid unit, angle = %s", s)
+	}
+
+	return FromUnit(value, unit), nil
+}
+
+/*
+parseDMS parses degrees, minutes and seconds, e.g. 56°22'30.5" or -8°37'.
+*/
+func parseDMS(s string) (Angle, error) {
+
+	text := strings.NewReplacer("°", " ", "'", " ", "′", " ", "\"", " ", "″", " ", ",", ".").Replace(s)
+	fields := strings.Fields(text)
+	if len(fields) == 0 || len(fields) > 3 {
+		return 0, fmt.Errorf("invalid angle, angle = %s", s)
+	}
+
+	sign := 1.0
+	if strings.HasPrefix(fields[0], "-") {
+		sign = -1.0
+		fields[0] = fields[0][1:]
+	}
+
+	deg := 0.0
+	scale := 1.0
+	for _, field := range fields {
+		value, err := strconv.ParseFloat(field, 64)
+		if err != nil || value < 0 {
+			return 0, fmt.Errorf("invalid angle, angle = %s", s)
+		}
+		deg += value / scale
+		scale *= 60
+	}
+
+	return FromDegrees(sign * deg), nil
+}
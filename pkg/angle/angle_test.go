@@ -0,0 +1,151 @@
+package angle
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+const _DIFF = 0.0000001
+
+func TestAngle_In(t *testing.T) {
+
+	var tests = []struct {
+		deg        float64 // in
+		rad        float64 // out
+		mils       float64 // out
+		warsawMils float64 // out
+		gon        float64 // out
+	}{
+		{0.0, 0.0, 0.0, 0.0, 0.0},
+		{90.0, math.Pi / 2.0, 1600.0, 1500.0, 100.0},
+		{180.0, math.Pi, 3200.0, 3000.0, 200.0},
+		{-45.0, -math.Pi / 4.0, -800.0, -750.0, -50.0},
+		{360.0, 2 * math.Pi, 6400.0, 6000.0, 400.0},
+	}
+
+	for _, test := range tests {
+		a := FromDegrees(test.deg)
+		got := []float64{a.Radians(), a.Mils(), a.WarsawMils(), a.Gon(), a.Degrees()}
+		want := []float64{test.rad, test.mils, test.warsawMils, test.gon, test.deg}
+		for i := range got {
+			if math.Abs(got[i]-want[i]) > _DIFF {
+				t.Errorf("\nFromDegrees(%f) -> %v != %v\n", test.deg, got, want)
+				break
+			}
+		}
+	}
+}
+
+func TestFromUnit(t *testing.T) {
+
+	var tests = []struct {
+		value float64 // in
+		unit  Unit    // in
+		deg   float64 // out
+	}{
+		{math.Pi, Radian, 180.0},
+		{1600, Mil, 90.0},
+		{1500, WarsawMil, 90.0},
+		{100, Gon, 90.0},
+		{90, Degree, 90.0},
+	}
+
+	for _, test := range tests {
+		got := FromUnit(test.value, test.unit).Degrees()
+		if math.Abs(got-test.deg) > _DIFF {
+			t.Errorf("\nFromUnit(%f, %s) -> %f != %f\n", test.value, test.unit, got, test.deg)
+		}
+	}
+}
+
+func TestAngle_Normalize(t *testing.T) {
+
+	var tests = []struct {
+		deg        float64 // in
+		normalized float64 // out
+	}{
+		{0.0, 0.0},
+		{-90.0, 270.0},
+		{450.0, 90.0},
+		{-720.0, 0.0},
+	}
+
+	for _, test := range tests {
+		got := FromDegrees(test.deg).Normalize().Degrees()
+		if math.Abs(got-test.normalized) > _DIFF {
+			t.Errorf("\nFromDegrees(%f).Normalize() -> %f != %f\n", test.deg, got, test.normalized)
+		}
+	}
+}
+
+func TestAngle_Format(t *testing.T) {
+
+	var tests = []struct {
+		angle    Angle  // in
+		unit     Unit   // in
+		decimals int    // in
+		text     string // out
+	}{
+		{FromDegrees(90), Degree, 2, "90.00°"},
+		{FromDegrees(90), Mil, 0, "1600 mil"},
+		{FromDegrees(90), WarsawMil, 0, "1500 wpmil"},
+		{FromDegrees(90), Gon, 1, "100.0 gon"},
+		{FromDegrees(90), Radian, 4, "1.5708 rad"},
+	}
+
+	for _, test := range tests {
+		got := test.angle.Format(test.unit, test.decimals)
+		if got != test.text {
+			t.Errorf("\nFormat(%s, %d) -> %s != %s\n", test.unit, test.decimals, got, test.text)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+
+	var tests = []struct {
+		text string  // in
+		deg  float64 // out
+		err  error   // out
+	}{
+		// positive tests
+		{"90", 90.0, nil},
+		{"90.5°", 90.5, nil},
+		{"90,5 deg", 90.5, nil},
+		{"-45 degrees", -45.0, nil},
+		{"3.141592653589793 rad", 180.0, nil},
+		{"1600 mil", 90.0, nil},
+		{"1600MILS", 90.0, nil},
+		{"1500 wpmil", 90.0, nil},
+		{"100 gon", 90.0, nil},
+		{"100g", 90.0, nil},
+		{"56°22'30\"", 56.375, nil},
+		{"-8°37′", -8.616667, nil},
+		// negative tests
+		{"", 0.0, fmt.Errorf("invalid empty angle string")},
+		{"north", 0.0, fmt.Errorf("invalid angle, angle = north")},
+		{"90 furlong", 0.0, fmt.Errorf("invalid unit, angle = 90 furlong")},
+		{"56°22'x\"", 0.0, fmt.Errorf("invalid angle, angle = 56°22'x\"")},
+	}
+
+	for _, test := range tests {
+		a, err := Parse(test.text)
+		function := fmt.Sprintf("Parse(%q)", test.text)
+		got := fmt.Sprintf("%.6f %v", a.Degrees(), err)
+		want := fmt.Sprintf("%.6f %v", test.deg, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func ExampleAngle_Format() {
+
+	bearing := FromDegrees(247.5)
+	fmt.Println(bearing.Format(Mil, 0))
+	fmt.Println(bearing.Format(Gon, 2))
+	// Output:
+	// 4400 mil
+	// 275.00 gon
+}
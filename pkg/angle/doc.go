@@ -0,0 +1,22 @@
+// Package angle converts angles between degrees, radians, mils and gon
+/*
+Bearings are given in different units by different users
+
+  - Degree: 360 per turn
+  - Radian: 2π per turn
+  - Mil: NATO mils, 6400 per turn
+  - WarsawMil: Warsaw Pact mils, 6000 per turn
+  - Gon: also named grad, 400 per turn
+
+An Angle holds the value in radians and is converted to and from the other units.
+
+	a := angle.FromDegrees(90)
+	a.Mils() // 1600
+	a.Gon()  // 100
+
+Angles can be parsed from and formatted to text with a unit symbol
+
+	a, err := angle.Parse("1600 mil")
+	a.Format(angle.Gon, 1) // "100.0 gon"
+*/
+package angle
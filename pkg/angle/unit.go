@@ -0,0 +1,81 @@
+package angle
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Unit defines the unit of an angle
+type Unit int
+
+const (
+	Degree    Unit = iota // 360 per turn
+	Radian                // 2π per turn
+	Mil                   // NATO mils, 6400 per turn
+	WarsawMil             // Warsaw Pact mils, 6000 per turn
+	Gon                   // gon or grad, 400 per turn
+)
+
+// PerTurn returns the number of units in a full turn
+func (unit Unit) PerTurn() float64 {
+	switch unit {
+	case Radian:
+		return 2 * math.Pi
+	case Mil:
+		return 6400
+	case WarsawMil:
+		return 6000
+	case Gon:
+		return 400
+	default:
+		return 360
+	}
+}
+
+// Symbol returns the symbol used when formatting an angle in the unit
+func (unit Unit) Symbol() string {
+	switch unit {
+	case Radian:
+		return "rad"
+	case Mil:
+		return "mil"
+	case WarsawMil:
+		return "wpmil"
+	case Gon:
+		return "gon"
+	default:
+		return "°"
+	}
+}
+
+// String returns the symbol of the unit
+func (unit Unit) String() string {
+	return unit.Symbol()
+}
+
+/*
+ParseUnit parses a unit symbol. The symbols are case-insensitive.
+
+  - Degree: "", "°", "d", "deg", "degree", "degrees"
+  - Radian: "rad", "radian", "radians"
+  - Mil: "mil", "mils", "‰"
+  - WarsawMil: "wpmil", "wpmils"
+  - Gon: "gon", "grad", "g"
+*/
+func ParseUnit(s string) (Unit, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "°", "d", "deg", "degree", "degrees":
+		return Degree, nil
+	case "rad", "radian", "radians":
+		return Radian, nil
+	case "mil", "mils", "‰":
+		return Mil, nil
+	case "wpmil", "wpmils":
+		return WarsawMil, nil
+	case "gon", "grad", "g":
+		return Gon, nil
+	default:
+		return Degree, fmt.Errorf("invalid unit, unit = %s", s)
+	}
+}
@@ -0,0 +1,155 @@
+package dk
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// DKTM defines a coordinate of the Danish transverse Mercator zones DKTM1 to DKTM4
+/*
+DKTM is transverse Mercator on ETRS89 / GRS80 with false northing -5000000 and latitude of origin 0,
+the zones are narrow to reduce the scale distortion for infrastructure like rail and roads.
+
+	- DKTM1 (EPSG:4093): central meridian 9°E, scale factor 0.99998, false easting 200000
+	- DKTM2 (EPSG:4094): central meridian 10°E, scale factor 0.99998, false easting 400000
+	- DKTM3 (EPSG:4095): central meridian 11.75°E, scale factor 0.99998, false easting 600000
+	- DKTM4 (EPSG:4096): central meridian 15°E, scale factor 1, false easting 800000
+
+	For the city of Skagen: "DKTM2 434563.42 1400117.53"
+*/
+type DKTM struct {
+	Zone     int
+	Easting  float64
+	Northing float64
+}
+
+// SystemDKTM is the name of the DKTM coordinate system
+const SystemDKTM = "DKTM"
+
+// dktmZones holds the projection of each zone
+var dktmZones = map[int]proj.TransverseMercator{
+	1: {Ellipsoid: proj.GRS80, Lon0: 9, K0: 0.99998, FalseEasting: 200000, FalseNorthing: -5000000},
+	2: {Ellipsoid: proj.GRS80, Lon0: 10, K0: 0.99998, FalseEasting: 400000, FalseNorthing: -5000000},
+	3: {Ellipsoid: proj.GRS80, Lon0: 11.75, K0: 0.99998, FalseEasting: 600000, FalseNorthing: -5000000},
+	4: {Ellipsoid: proj.GRS80, Lon0: 15, K0: 1, FalseEasting: 800000, FalseNorthing: -5000000},
+}
+
+// dktmPattern matches zone, easting and northing
+var dktmPattern = regexp.MustCompile(`^DKTM([1-4])\s+(\d+(?:\.\d+)?)\s+(-?\d+(?:\.\d+)?)$`)
+
+func init() {
+	proj.Register(SystemDKTM, func(ll proj.LL) (proj.Coordinate, error) { return DKTMFromLL(ll, DKTMZone(ll)) })
+	proj.RegisterParser(SystemDKTM, func(text string) (proj.Coordinate, float64, error) {
+		dktm, err := ParseDKTM(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		return dktm, 1.0, nil
+	})
+}
+
+/*
+DKTMZone returns the DKTM zone of latitude longitude.
+
+Bornholm is DKTM4, Sjælland DKTM3, and Jylland and Fyn DKTM1 or DKTM2 by the nearest central meridian.
+
+	For the city of Skagen: "57.720000 10.580000" -> 2
+*/
+func DKTMZone(ll proj.LL) int {
+	switch RegionOf(ll) {
+	case Bornholm:
+		return 4
+	case Sjaelland:
+		return 3
+	default:
+		if ll.Lon < 9.5 {
+			return 1
+		}
+		return 2
+	}
+}
+
+/*
+DKTMFromLL projects ETRS89 latitude longitude to DKTM in the given zone.
+
+	For the city of Skagen: DKTMFromLL(proj.LL{Lat: 57.72, Lon: 10.58}, 2) -> "DKTM2 434563.42 1400117.53"
+*/
+func DKTMFromLL(ll proj.LL, zone int) (DKTM, error) {
+
+	tm, ok := dktmZones[zone]
+	if !ok {
+		return DKTM{}, fmt.Errorf("invalid zone number, zone number = %v", zone)
+	}
+	easting, northing, err := tm.Forward(ll)
+	if err != nil {
+		return DKTM{}, err
+	}
+	return DKTM{Zone: zone, Easting: easting, Northing: northing}, nil
+}
+
+/*
+DKTMFromUTM converts ETRS89 UTM to DKTM in the given zone.
+*/
+func DKTMFromUTM(utm proj.UTM, zone int) (DKTM, error) {
+
+	ll, err := utm.ToLL()
+	if err != nil {
+		return DKTM{}, fmt.Errorf("error <%v> at utm.ToLL(), utm = %s", err, utm)
+	}
+	return DKTMFromLL(ll, zone)
+}
+
+/*
+ParseDKTM parses a DKTM coordinate with the zone name, easting and northing.
+
+	For the city of Skagen: "DKTM2 434563.42 1400117.53"
+*/
+func ParseDKTM(s string) (DKTM, error) {
+
+	m := dktmPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(s)))
+	if m == nil {
+		return DKTM{}, fmt.Errorf("bad conversion, dktm = %s", s)
+	}
+	zone, _ := strconv.Atoi(m[1])
+	easting, _ := strconv.ParseFloat(m[2], 64)
+	northing, _ := strconv.ParseFloat(m[3], 64)
+	return DKTM{Zone: zone, Easting: easting, Northing: northing}, nil
+}
+
+// String returns the zone name, easting and northing of the DKTM coordinate
+func (dktm DKTM) String() string {
+	return fmt.Sprintf("DKTM%d %.2f %.2f", dktm.Zone, dktm.Easting, dktm.Northing)
+}
+
+// System returns the name of the coordinate system
+func (dktm DKTM) System() string {
+	return SystemDKTM
+}
+
+/*
+ToLL converts DKTM to ETRS89 latitude longitude.
+*/
+func (dktm DKTM) ToLL() (proj.LL, error) {
+
+	tm, ok := dktmZones[dktm.Zone]
+	if !ok {
+		return proj.LL{}, fmt.Errorf("invalid zone number, zone number = %v", dktm.Zone)
+	}
+	return tm.Inverse(dktm.Easting, dktm.Northing)
+}
+
+/*
+ToUTM converts DKTM to ETRS89 UTM in zone 32, the zone of Danish data including Bornholm.
+*/
+func (dktm DKTM) ToUTM() (proj.UTM, error) {
+
+	ll, err := dktm.ToLL()
+	if err != nil {
+		return proj.UTM{}, err
+	}
+	return ll.ToUTMZone(32, proj.North)
+}
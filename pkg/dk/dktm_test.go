@@ -0,0 +1,140 @@
+package dk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestDKTMFromLL(t *testing.T) {
+
+	var tests = []struct {
+		ll   proj.LL // in
+		zone int     // in
+		dktm string  // out
+		err  error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 56.1629, Lon: 8.0}, 1, "DKTM1 137872.36 1227026.66", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 2, "DKTM2 434563.42 1400117.53", nil},
+		{proj.LL{Lat: 55.4038, Lon: 10.4024}, 2, "DKTM2 425491.39 1142135.10", nil},
+		{proj.LL{Lat: 55.6761, Lon: 12.5683}, 3, "DKTM3 651480.52 1172680.51", nil},
+		{proj.LL{Lat: 55.1003, Lon: 14.7065}, 4, "DKTM4 781264.63 1108435.51", nil},
+		// negative tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 5, "DKTM0 0.00 0.00", fmt.Errorf("invalid zone number, zone number = 5")},
+		{proj.LL{Lat: 57.72, Lon: 190.58}, 2, "DKTM0 0.00 0.00", fmt.Errorf("invalid longitude, lon = 190.58")},
+	}
+
+	for _, test := range tests {
+		dktm, err := DKTMFromLL(test.ll, test.zone)
+		function := fmt.Sprintf("DKTMFromLL(%s, %d)", test.ll, test.zone)
+		got := fmt.Sprintf("%s %v", dktm, err)
+		want := fmt.Sprintf("%s %v", test.dktm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		ll, err := dktm.ToLL()
+		function = fmt.Sprintf("%s.ToLL()", dktm)
+		got = fmt.Sprintf("%.9f %.9f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.9f %.9f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestDKTM_Kp2000 compares DKTM4 with Kp2000 Bornholm, which have the same central meridian and scale factor
+func TestDKTM_Kp2000(t *testing.T) {
+
+	ll := proj.LL{Lat: 55.1003, Lon: 14.7065}
+	dktm, _ := DKTMFromLL(ll, 4)
+	kp, _ := Kp2000FromLL(ll, Bornholm)
+
+	got := fmt.Sprintf("%.3f %.3f", dktm.Easting+100000, dktm.Northing+5000000)
+	want := fmt.Sprintf("%.3f %.3f", kp.Easting, kp.Northing)
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "DKTM4 - Kp2000B", got, want)
+	}
+}
+
+func TestDKTM_ToUTM(t *testing.T) {
+
+	dktm, err := DKTMFromUTM(proj.UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594115.32, Northing: 6398634.81}, 2)
+	got := fmt.Sprintf("%s %v", dktm, err)
+	want := "DKTM2 434563.43 1400117.53 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "DKTMFromUTM()", got, want)
+	}
+
+	utm, err := dktm.ToUTM()
+	got = fmt.Sprintf("%s %v", utm, err)
+	want = "32V 594115.32 6398634.81 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "ToUTM()", got, want)
+	}
+
+	_, err = DKTM{Zone: 0}.ToUTM()
+	got = fmt.Sprintf("%v", err)
+	want = "invalid zone number, zone number = 0"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "ToUTM()", got, want)
+	}
+}
+
+func TestDKTMZone(t *testing.T) {
+
+	var tests = []struct {
+		ll   proj.LL // in
+		zone int     // out
+	}{
+		{proj.LL{Lat: 56.1629, Lon: 8.0}, 1},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 2},
+		{proj.LL{Lat: 55.4038, Lon: 10.4024}, 2},
+		{proj.LL{Lat: 55.6761, Lon: 12.5683}, 3},
+		{proj.LL{Lat: 55.1003, Lon: 14.7065}, 4},
+	}
+
+	for _, test := range tests {
+		if got := DKTMZone(test.ll); got != test.zone {
+			t.Errorf("\n%s -> %d != %d\n", fmt.Sprintf("DKTMZone(%s)", test.ll), got, test.zone)
+		}
+	}
+}
+
+func TestParseDKTM(t *testing.T) {
+
+	var tests = []struct {
+		s    string // in
+		dktm string // out
+		err  error  // out
+	}{
+		// positive tests
+		{"DKTM2 434563.42 1400117.53", "DKTM2 434563.42 1400117.53", nil},
+		{"dktm4  781264.63 1108435.51", "DKTM4 781264.63 1108435.51", nil},
+		// negative tests
+		{"DKTM5 434563.42 1400117.53", "DKTM0 0.00 0.00", fmt.Errorf("bad conversion, dktm = DKTM5 434563.42 1400117.53")},
+		{"DKTM2 434563.42", "DKTM0 0.00 0.00", fmt.Errorf("bad conversion, dktm = DKTM2 434563.42")},
+	}
+
+	for _, test := range tests {
+		dktm, err := ParseDKTM(test.s)
+		function := fmt.Sprintf("ParseDKTM(%s)", test.s)
+		got := fmt.Sprintf("%s %v", dktm, err)
+		want := fmt.Sprintf("%s %v", test.dktm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+
+	c, err := proj.Convert[DKTM](proj.UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594115.32, Northing: 6398634.81})
+	got := fmt.Sprintf("%s %v", c, err)
+	want := "DKTM2 434563.43 1400117.53 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "proj.Convert[DKTM]()", got, want)
+	}
+}
@@ -0,0 +1,23 @@
+// Package dk converts between the Danish projections and ETRS89 latitude longitude or UTM
+/*
+The package supports
+
+  - Kp2000 for Jylland, Sjælland and Bornholm, transverse Mercator on GRS80 with published parameters
+
+Municipal archives are often in Kp2000, while current Danish data is in ETRS89 UTM zone 32 (EPSG:25832).
+
+	kp, err := dk.Kp2000FromLL(proj.LL{Lat: 57.72, Lon: 10.58}, dk.Jylland)
+	utm, err := kp.ToUTM()
+
+System 34 and System 45 are not supported, they are transformed by polynomials of the Danish agency
+which are not distributed with the package.
+
+The package registers the coordinate system KP2000 with package proj, see [proj.Convert] and [proj.Parse].
+
+Links:
+  - https://epsg.io/2196
+  - https://epsg.io/2197
+  - https://epsg.io/2198
+  - https://en.wikipedia.org/wiki/System_34
+*/
+package dk
@@ -0,0 +1,132 @@
+package dk
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Kp2000 defines a coordinate of the Danish Kp2000 projections
+/*
+Kp2000 is transverse Mercator on ETRS89 / GRS80 with a zone for each region, false northing 0 and latitude of origin 0.
+
+	- Jylland (EPSG:2196): central meridian 9.5°E, scale factor 0.99995, false easting 200000
+	- Sjælland (EPSG:2197): central meridian 12°E, scale factor 0.99995, false easting 500000
+	- Bornholm (EPSG:2198): central meridian 15°E, scale factor 1, false easting 900000
+
+	For the city of Skagen: "KP2000J 264356.38 6400290.44"
+*/
+type Kp2000 struct {
+	Region   Region
+	Easting  float64
+	Northing float64
+}
+
+// SystemKp2000 is the name of the Kp2000 coordinate system
+const SystemKp2000 = "KP2000"
+
+// kp2000Zones holds the projection of each region
+var kp2000Zones = map[Region]proj.TransverseMercator{
+	Jylland:   {Ellipsoid: proj.GRS80, Lon0: 9.5, K0: 0.99995, FalseEasting: 200000},
+	Sjaelland: {Ellipsoid: proj.GRS80, Lon0: 12, K0: 0.99995, FalseEasting: 500000},
+	Bornholm:  {Ellipsoid: proj.GRS80, Lon0: 15, K0: 1, FalseEasting: 900000},
+}
+
+// kp2000Pattern matches region, easting and northing
+var kp2000Pattern = regexp.MustCompile(`^KP2000([JSB])\s+(\d+(?:\.\d+)?)\s+(\d+(?:\.\d+)?)$`)
+
+func init() {
+	proj.Register(SystemKp2000, func(ll proj.LL) (proj.Coordinate, error) { return Kp2000FromLL(ll, RegionOf(ll)) })
+	proj.RegisterParser(SystemKp2000, func(text string) (proj.Coordinate, float64, error) {
+		kp, err := ParseKp2000(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		return kp, 1.0, nil
+	})
+}
+
+/*
+Kp2000FromLL projects ETRS89 latitude longitude to Kp2000 in the given region.
+
+ETRS89 and WGS84 differ by less than a meter in Denmark.
+
+	For the city of Skagen: Kp2000FromLL(proj.LL{Lat: 57.72, Lon: 10.58}, Jylland) -> "KP2000J 264356.38 6400290.44"
+*/
+func Kp2000FromLL(ll proj.LL, region Region) (Kp2000, error) {
+
+	zone, ok := kp2000Zones[region]
+	if !ok {
+		return Kp2000{}, fmt.Errorf("invalid region, region = %s", region)
+	}
+	easting, northing, err := zone.Forward(ll)
+	if err != nil {
+		return Kp2000{}, err
+	}
+	return Kp2000{Region: region, Easting: easting, Northing: northing}, nil
+}
+
+/*
+Kp2000FromUTM converts ETRS89 UTM to Kp2000 in the given region.
+*/
+func Kp2000FromUTM(utm proj.UTM, region Region) (Kp2000, error) {
+
+	ll, err := utm.ToLL()
+	if err != nil {
+		return Kp2000{}, fmt.Errorf("error <%v> at utm.ToLL(), utm = %s", err, utm)
+	}
+	return Kp2000FromLL(ll, region)
+}
+
+/*
+ParseKp2000 parses a Kp2000 coordinate with the zone name, easting and northing.
+
+	For the city of Skagen: "KP2000J 264356.38 6400290.44"
+*/
+func ParseKp2000(s string) (Kp2000, error) {
+
+	m := kp2000Pattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(s)))
+	if m == nil {
+		return Kp2000{}, fmt.Errorf("bad conversion, kp2000 = %s", s)
+	}
+	easting, _ := strconv.ParseFloat(m[2], 64)
+	northing, _ := strconv.ParseFloat(m[3], 64)
+	return Kp2000{Region: Region(m[1][0]), Easting: easting, Northing: northing}, nil
+}
+
+// String returns the zone name, easting and northing of the Kp2000 coordinate
+func (kp Kp2000) String() string {
+	return fmt.Sprintf("KP2000%c %.2f %.2f", kp.Region, kp.Easting, kp.Northing)
+}
+
+// System returns the name of the coordinate system
+func (kp Kp2000) System() string {
+	return SystemKp2000
+}
+
+/*
+ToLL converts Kp2000 to ETRS89 latitude longitude.
+*/
+func (kp Kp2000) ToLL() (proj.LL, error) {
+
+	zone, ok := kp2000Zones[kp.Region]
+	if !ok {
+		return proj.LL{}, fmt.Errorf("invalid region, region = %s", kp.Region)
+	}
+	return zone.Inverse(kp.Easting, kp.Northing)
+}
+
+/*
+ToUTM converts Kp2000 to ETRS89 UTM in zone 32, the zone of Danish data including Bornholm.
+*/
+func (kp Kp2000) ToUTM() (proj.UTM, error) {
+
+	ll, err := kp.ToLL()
+	if err != nil {
+		return proj.UTM{}, err
+	}
+	return ll.ToUTMZone(32, proj.North)
+}
@@ -0,0 +1,125 @@
+package dk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestKp2000FromLL(t *testing.T) {
+
+	var tests = []struct {
+		ll     proj.LL // in
+		region Region  // in
+		kp     string  // out
+		err    error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, Jylland, "KP2000J 264356.38 6400290.44", nil},
+		{proj.LL{Lat: 55.6761, Lon: 12.5683}, Sjaelland, "KP2000S 535751.79 6172338.16", nil},
+		{proj.LL{Lat: 55.1003, Lon: 14.7065}, Bornholm, "KP2000B 881264.63 6108435.51", nil},
+		{proj.LL{Lat: 56, Lon: 9.5}, Jylland, "KP2000J 200000.00 6208252.58", nil},
+		// negative tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 'X', "KP2000\x00 0.00 0.00", fmt.Errorf("invalid region, region = unknown region 'X'")},
+		{proj.LL{Lat: 97.72, Lon: 10.58}, Jylland, "KP2000\x00 0.00 0.00", fmt.Errorf("invalid latitude, lat = 97.72")},
+	}
+
+	for _, test := range tests {
+		kp, err := Kp2000FromLL(test.ll, test.region)
+		function := fmt.Sprintf("Kp2000FromLL(%s, %s)", test.ll, test.region)
+		got := fmt.Sprintf("%s %v", kp, err)
+		want := fmt.Sprintf("%s %v", test.kp, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestKp2000_ToLL(t *testing.T) {
+
+	for _, region := range []Region{Jylland, Sjaelland, Bornholm} {
+		for _, ll := range []proj.LL{{Lat: 57.72, Lon: 10.58}, {Lat: 55.6761, Lon: 12.5683}, {Lat: 55.1003, Lon: 14.7065}} {
+			kp, _ := Kp2000FromLL(ll, region)
+			back, err := kp.ToLL()
+			function := fmt.Sprintf("%s.ToLL()", kp)
+			got := fmt.Sprintf("%.9f %.9f %v", back.Lat, back.Lon, err)
+			want := fmt.Sprintf("%.9f %.9f <nil>", ll.Lat, ll.Lon)
+			if got != want {
+				t.Errorf("\n%s -> %s != %s\n", function, got, want)
+			}
+		}
+	}
+}
+
+func TestKp2000_ToUTM(t *testing.T) {
+
+	kp := Kp2000{Region: Jylland, Easting: 264356.38, Northing: 6400290.44}
+	utm, err := kp.ToUTM()
+	got := fmt.Sprintf("%s %v", utm, err)
+	want := "32V 594115.32 6398634.80 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "ToUTM()", got, want)
+	}
+
+	kp, err = Kp2000FromUTM(utm, Jylland)
+	got = fmt.Sprintf("%s %v", kp, err)
+	want = "KP2000J 264356.38 6400290.44 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "Kp2000FromUTM()", got, want)
+	}
+}
+
+func TestParseKp2000(t *testing.T) {
+
+	var tests = []struct {
+		s   string // in
+		kp  string // out
+		err error  // out
+	}{
+		// positive tests
+		{"KP2000J 264356.38 6400290.44", "KP2000J 264356.38 6400290.44", nil},
+		{" kp2000b 881264.63   6108435.51 ", "KP2000B 881264.63 6108435.51", nil},
+		// negative tests
+		{"KP2000X 264356.38 6400290.44", "KP2000\x00 0.00 0.00", fmt.Errorf("bad conversion, kp2000 = KP2000X 264356.38 6400290.44")},
+		{"KP2000J 264356.38", "KP2000\x00 0.00 0.00", fmt.Errorf("bad conversion, kp2000 = KP2000J 264356.38")},
+	}
+
+	for _, test := range tests {
+		kp, err := ParseKp2000(test.s)
+		function := fmt.Sprintf("ParseKp2000(%s)", test.s)
+		got := fmt.Sprintf("%s %v", kp, err)
+		want := fmt.Sprintf("%s %v", test.kp, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+
+	parsed, err := proj.Parse("KP2000S 535751.79 6172338.16")
+	got := fmt.Sprintf("%s %s %v", parsed.System, parsed.Coordinate, err)
+	want := "KP2000 KP2000S 535751.79 6172338.16 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "proj.Parse()", got, want)
+	}
+}
+
+func TestRegionOf(t *testing.T) {
+
+	var tests = []struct {
+		ll     proj.LL // in
+		region Region  // out
+	}{
+		{proj.LL{Lat: 57.72, Lon: 10.58}, Jylland},
+		{proj.LL{Lat: 55.3959, Lon: 10.3883}, Jylland},
+		{proj.LL{Lat: 55.6761, Lon: 12.5683}, Sjaelland},
+		{proj.LL{Lat: 55.1003, Lon: 14.7065}, Bornholm},
+		{proj.LL{Lat: 55.32, Lon: 15.19}, Bornholm},
+	}
+
+	for _, test := range tests {
+		got := RegionOf(test.ll)
+		if got != test.region {
+			t.Errorf("\n%s -> %s != %s\n", fmt.Sprintf("RegionOf(%s)", test.ll), got, test.region)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package dk
+
+import (
+	"fmt"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Region defines the regions of the Danish projections
+/*
+	- Jylland: Jylland and Fyn
+	- Sjaelland: Sjælland, Lolland, Falster and Møn
+	- Bornholm: Bornholm and Christiansø
+*/
+type Region byte
+
+const (
+	Jylland   Region = 'J'
+	Sjaelland Region = 'S'
+	Bornholm  Region = 'B'
+)
+
+// String returns the name of the region
+func (region Region) String() string {
+	switch region {
+	case Jylland:
+		return "Jylland"
+	case Sjaelland:
+		return "Sjælland"
+	case Bornholm:
+		return "Bornholm"
+	default:
+		return fmt.Sprintf("unknown region %q", byte(region))
+	}
+}
+
+// valid reports whether the region is one of the Danish regions
+func (region Region) valid() bool {
+	return region == Jylland || region == Sjaelland || region == Bornholm
+}
+
+/*
+RegionOf returns the region of latitude longitude.
+
+The border between Jylland and Sjælland follows the meridian 11°E in Storebælt,
+Bornholm is east of 14.5°E and south of 55.4°N.
+
+	For the city of Skagen: "57.720000 10.580000" -> Jylland
+*/
+func RegionOf(ll proj.LL) Region {
+	switch {
+	case ll.Lon >= 14.5 && ll.Lat < 55.4:
+		return Bornholm
+	case ll.Lon >= 11:
+		return Sjaelland
+	default:
+		return Jylland
+	}
+}
@@ -0,0 +1,187 @@
+package dkn
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/grid"
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Cell defines a cell of DKN by its size and the south-west corner in ETRS89 / UTM zone 32N
+/*
+For the city of Skagen: "1km_6399_594" is the cell with the south-west corner 594000 6399000
+*/
+type Cell struct {
+	Size  Size
+	North int64 // northing of the south-west corner in meters
+	East  int64 // easting of the south-west corner in meters
+}
+
+// System is the name of the DKN coordinate system
+const System = "DKN"
+
+// DefaultSize is the size used by the conversions registered with package proj
+const DefaultSize = Size100m
+
+// zone is the UTM zone of DKN
+const zone = 32
+
+// dknPattern matches size, northing and easting of a cell identifier
+var dknPattern = regexp.MustCompile(`^(\d+k?m)_(\d+)_(\d+)$`)
+
+func init() {
+	proj.Register(System, func(ll proj.LL) (proj.Coordinate, error) { return EncodeLL(ll, DefaultSize) })
+	proj.RegisterConverter(proj.SystemUTM, System, func(c proj.Coordinate) (proj.Coordinate, error) {
+		return Encode(c.(proj.UTM), DefaultSize)
+	})
+	proj.RegisterConverter(System, proj.SystemUTM, func(c proj.Coordinate) (proj.Coordinate, error) {
+		return c.(Cell).Center(), nil
+	})
+	proj.RegisterParser(System, func(text string) (proj.Coordinate, float64, error) {
+		cell, err := Decode(strings.TrimSpace(text))
+		if err != nil {
+			return nil, 0, err
+		}
+		return cell, 1.0, nil
+	})
+}
+
+/*
+Encode returns the cell of the given size containing the UTM coordinate.
+
+UTM coordinates in other zones than 32 are converted to zone 32.
+
+	For the city of Skagen: Encode(proj.UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, Size1km) -> "1km_6399_594"
+*/
+func Encode(utm proj.UTM, size Size) (Cell, error) {
+
+	if !size.valid() {
+		return Cell{}, fmt.Errorf("invalid size, size = %d", size)
+	}
+	if utm.ZoneNumber != zone {
+		var err error
+		if utm, err = utm.ToZone(zone); err != nil {
+			return Cell{}, err
+		}
+	}
+	if utm.ToHemisphere().Hemisphere != proj.North {
+		return Cell{}, fmt.Errorf("southern hemisphere, utm = %s", utm)
+	}
+
+	return Cell{Size: size, North: grid.CellCorner(utm.Northing, int64(size)), East: grid.CellCorner(utm.Easting, int64(size))}, nil
+}
+
+/*
+EncodeLL returns the cell of the given size containing latitude longitude.
+
+	For the city of Rønne on Bornholm: EncodeLL(proj.LL{Lat: 55.1003, Lon: 14.7065}, Size1km) -> "1km_6120_863"
+*/
+func EncodeLL(ll proj.LL, size Size) (Cell, error) {
+
+	utm, err := ll.ToUTMZone(zone, proj.North)
+	if err != nil {
+		return Cell{}, err
+	}
+	return Encode(utm, size)
+}
+
+/*
+Decode decodes a cell identifier.
+
+	For the city of Skagen: "1km_6399_594" -> south-west corner 594000 6399000
+*/
+func Decode(id string) (Cell, error) {
+
+	m := dknPattern.FindStringSubmatch(id)
+	if m == nil {
+		return Cell{}, fmt.Errorf("bad conversion, dkn = %s", id)
+	}
+	size, ok := sizes[m[1]]
+	if !ok {
+		return Cell{}, fmt.Errorf("invalid size, dkn = %s", id)
+	}
+	north, errNorth := strconv.ParseInt(m[2], 10, 64)
+	east, errEast := strconv.ParseInt(m[3], 10, 64)
+	if errNorth != nil || errEast != nil {
+		return Cell{}, fmt.Errorf("bad conversion, dkn = %s", id)
+	}
+
+	cell := Cell{Size: size, North: north * int64(size), East: east * int64(size)}
+	if cell.North >= 10000000 || cell.East >= 1000000 {
+		return Cell{}, fmt.Errorf("invalid cell, dkn = %s", id)
+	}
+	return cell, nil
+}
+
+// SouthWest returns the south-west corner of the cell
+func (cell Cell) SouthWest() proj.UTM {
+	return zoneUTM(cell.East, cell.North)
+}
+
+// NorthEast returns the north-east corner of the cell
+func (cell Cell) NorthEast() proj.UTM {
+	return zoneUTM(cell.East+int64(cell.Size), cell.North+int64(cell.Size))
+}
+
+// Center returns the centre of the cell
+func (cell Cell) Center() proj.UTM {
+	center := zoneUTM(cell.East, cell.North)
+	center.Easting += float64(cell.Size) / 2
+	center.Northing += float64(cell.Size) / 2
+	return center
+}
+
+/*
+Polygon returns the corners of the cell in UTM zone 32N as a closed ring counter-clockwise from the south-west corner.
+*/
+func (cell Cell) Polygon() []proj.UTM {
+	polygon := make([]proj.UTM, 0, 5)
+	for _, corner := range grid.SquareRing(cell.East, cell.North, int64(cell.Size)) {
+		polygon = append(polygon, zoneUTM(corner[0], corner[1]))
+	}
+	return polygon
+}
+
+/*
+PolygonLL returns the corners of the cell in latitude longitude as a closed ring counter-clockwise from the south-west corner.
+
+The sides of the cell are straight in UTM, and only approximately along the meridians and parallels.
+*/
+func (cell Cell) PolygonLL() ([]proj.LL, error) {
+
+	polygon := make([]proj.LL, 0, 5)
+	for _, corner := range cell.Polygon() {
+		ll, err := corner.ToLL()
+		if err != nil {
+			return nil, fmt.Errorf("error <%v> at utm.ToLL(), utm = %s", err, corner)
+		}
+		polygon = append(polygon, ll)
+	}
+	return polygon, nil
+}
+
+// String returns the cell identifier
+func (cell Cell) String() string {
+	if !cell.Size.valid() {
+		return fmt.Sprintf("invalid size, size = %d", cell.Size)
+	}
+	return fmt.Sprintf("%s_%d_%d", cell.Size, cell.North/int64(cell.Size), cell.East/int64(cell.Size))
+}
+
+// System returns the name of the coordinate system
+func (cell Cell) System() string {
+	return System
+}
+
+// ToLL converts the cell to latitude longitude of its centre
+func (cell Cell) ToLL() (proj.LL, error) {
+	return cell.Center().ToLL()
+}
+
+// zoneUTM returns the UTM coordinate in zone 32N
+func zoneUTM(east, north int64) proj.UTM {
+	return proj.UTM{ZoneNumber: zone, Hemisphere: proj.North, Easting: float64(east), Northing: float64(north)}
+}
@@ -0,0 +1,170 @@
+package dkn
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestEncode(t *testing.T) {
+
+	skagen := proj.UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}
+	var tests = []struct {
+		utm  proj.UTM // in
+		size Size     // in
+		id   string   // out
+		err  error    // out
+	}{
+		// positive tests
+		{skagen, Size100m, "100m_63990_5948", nil},
+		{skagen, Size250m, "250m_25596_2379", nil},
+		{skagen, Size1km, "1km_6399_594", nil},
+		{skagen, Size10km, "10km_639_59", nil},
+		{skagen, Size100km, "100km_63_5", nil},
+		{proj.UTM{ZoneNumber: 32, Hemisphere: proj.North, Easting: 720000, Northing: 6180000}, Size1km, "1km_6180_720", nil},
+		{proj.UTM{ZoneNumber: 32, Hemisphere: proj.North, Easting: 720999.9999999, Northing: 6180999.9999999}, Size1km, "1km_6181_721", nil},
+		{proj.UTM{ZoneNumber: 33, ZoneLetter: 'U', Easting: 481272.13, Northing: 6105992.14}, Size1km, "1km_6120_863", nil},
+		// negative tests
+		{skagen, 500, "invalid size, size = 0", fmt.Errorf("invalid size, size = 500")},
+		{proj.UTM{ZoneNumber: 32, ZoneLetter: 'M', Easting: 500000, Northing: 9000000}, Size1km, "invalid size, size = 0", fmt.Errorf("southern hemisphere, utm = 32M 500000.00 9000000.00")},
+	}
+
+	for _, test := range tests {
+		cell, err := Encode(test.utm, test.size)
+		function := fmt.Sprintf("Encode(%s, %s)", test.utm, test.size)
+		got := fmt.Sprintf("%s %v", cell, err)
+		want := fmt.Sprintf("%s %v", test.id, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestEncode_KmKv joins the cells with the KmKv column of records of cities.csv
+func TestEncode_KmKv(t *testing.T) {
+
+	var tests = []struct {
+		record []string // in
+	}{
+		{[]string{"1", "Skagen", "9990", "Frederikshavn", "Region Nordjylland", "8000", "57.72", "10.58", "32", "V", "1km_6399_594", "594", "6399", "594857.92", "6399059.92"}},
+	}
+
+	for _, test := range tests {
+		var city proj.City
+		city.BuildCity(test.record)
+
+		cell, err := Encode(city.Utm, Size1km)
+		function := fmt.Sprintf("Encode(%s, Size1km)", city.Utm)
+		got := fmt.Sprintf("%s %d %d %v", cell, cell.East, cell.North, err)
+		want := fmt.Sprintf("%s %d %d <nil>", city.KmKv(), city.East*1000, city.North*1000)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestEncodeLL(t *testing.T) {
+
+	var tests = []struct {
+		ll   proj.LL // in
+		size Size    // in
+		id   string  // out
+		err  error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 55.1003, Lon: 14.7065}, Size1km, "1km_6120_863", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, Size100m, "100m_63986_5941", nil},
+		// negative tests
+		{proj.LL{Lat: 57.72, Lon: 190}, Size1km, "invalid size, size = 0", fmt.Errorf("invalid longitude, lon = 190")},
+	}
+
+	for _, test := range tests {
+		cell, err := EncodeLL(test.ll, test.size)
+		function := fmt.Sprintf("EncodeLL(%s, %s)", test.ll, test.size)
+		got := fmt.Sprintf("%s %v", cell, err)
+		want := fmt.Sprintf("%s %v", test.id, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+
+	var tests = []struct {
+		id   string // in
+		cell Cell   // out
+		err  error  // out
+	}{
+		// positive tests
+		{"1km_6180_720", Cell{Size1km, 6180000, 720000}, nil},
+		{"10km_618_72", Cell{Size10km, 6180000, 720000}, nil},
+		{"100m_61805_7203", Cell{Size100m, 6180500, 720300}, nil},
+		{"250m_25596_2379", Cell{Size250m, 6399000, 594750}, nil},
+		// negative tests
+		{"1km-6180-720", Cell{}, fmt.Errorf("bad conversion, dkn = 1km-6180-720")},
+		{"1KM_6180_720", Cell{}, fmt.Errorf("bad conversion, dkn = 1KM_6180_720")},
+		{"5km_1236_144", Cell{}, fmt.Errorf("invalid size, dkn = 5km_1236_144")},
+		{"1km_6180_1000", Cell{}, fmt.Errorf("invalid cell, dkn = 1km_6180_1000")},
+		{"1km_10000_720", Cell{}, fmt.Errorf("invalid cell, dkn = 1km_10000_720")},
+	}
+
+	for _, test := range tests {
+		cell, err := Decode(test.id)
+		function := fmt.Sprintf("Decode(%s)", test.id)
+		got := fmt.Sprintf("%#v %v", cell, err)
+		want := fmt.Sprintf("%#v %v", test.cell, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestCell_Polygon(t *testing.T) {
+
+	cell := Cell{Size: Size1km, North: 6399000, East: 594000}
+
+	got := fmt.Sprintf("%s", cell.Polygon())
+	want := "[32N 594000.00 6399000.00 32N 595000.00 6399000.00 32N 595000.00 6400000.00 32N 594000.00 6400000.00 32N 594000.00 6399000.00]"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "Polygon()", got, want)
+	}
+
+	polygon, err := cell.PolygonLL()
+	got = fmt.Sprintf("%s %v", polygon, err)
+	want = "[57.723303 10.578208 57.723093 10.594990 57.732072 10.595385 57.732282 10.578599 57.723303 10.578208] <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "PolygonLL()", got, want)
+	}
+
+	got = fmt.Sprintf("%s %s %s", cell.SouthWest(), cell.Center(), cell.NorthEast())
+	want = "32N 594000.00 6399000.00 32N 594500.00 6399500.00 32N 595000.00 6400000.00"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "SouthWest(), Center(), NorthEast()", got, want)
+	}
+}
+
+func TestCell_Coordinate(t *testing.T) {
+
+	parsed, err := proj.Parse("1km_6399_594")
+	got := fmt.Sprintf("%s %s %v %v", parsed.System, parsed.Coordinate, parsed.Ambiguous(), err)
+	want := "DKN 1km_6399_594 false <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "proj.Parse(1km_6399_594)", got, want)
+	}
+
+	mgrs, err := proj.Convert[proj.MGRS](Cell{Size: Size1km, North: 6399000, East: 594000})
+	got = fmt.Sprintf("%s %v", mgrs, err)
+	want = "32VNJ9450099500 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "proj.Convert[MGRS](1km_6399_594)", got, want)
+	}
+
+	cell, err := proj.Convert[Cell](proj.UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92})
+	got = fmt.Sprintf("%s %v", cell, err)
+	want = "100m_63990_5948 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "proj.Convert[Cell](UTM)", got, want)
+	}
+}
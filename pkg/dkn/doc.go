@@ -0,0 +1,25 @@
+// Package dkn encodes positions as cells of the Danish square grid (Det Danske Kvadratnet, DKN)
+/*
+DKN divides ETRS89 / UTM zone 32N (EPSG:25832) in squares of 100 m, 250 m, 1 km, 10 km and 100 km.
+The identifier of a cell is the size followed by the northing and easting of the south-west corner divided by the size.
+
+	For the city of Skagen: "32V 594857.92 6399059.92"
+	- "100m_63990_5948"
+	- "1km_6399_594"
+	- "10km_639_59"
+
+The cells of Bornholm are also in zone 32, positions in zone 33 are converted to zone 32 before encoding.
+
+	cell, err := dkn.Encode(proj.UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, dkn.Size1km)
+	cell, err := dkn.Decode(city.KmKv())
+	polygon := cell.Polygon()
+
+Danish statistics, e.g. from Danmarks Statistik, are published on the cells of DKN.
+
+The package registers the coordinate system DKN with package proj, see [proj.Convert] and [proj.Parse].
+
+Links:
+  - https://www.dst.dk/da/TilSalg/produkter/geodata/kvadratnet
+  - https://epsg.io/25832
+*/
+package dkn
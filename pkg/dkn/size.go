@@ -0,0 +1,36 @@
+package dkn
+
+import (
+	"github.com/brundtoe/go-geografi/pkg/grid"
+)
+
+// Size defines the side of a DKN cell in meters
+type Size int64
+
+const (
+	Size100m  Size = 100    // e.g. 100m_63990_5948
+	Size250m  Size = 250    // e.g. 250m_25596_2379
+	Size1km   Size = 1000   // e.g. 1km_6399_594
+	Size10km  Size = 10000  // e.g. 10km_639_59
+	Size100km Size = 100000 // e.g. 100km_63_5
+)
+
+// sizes holds the sizes of DKN by their name in the cell identifier
+var sizes = map[string]Size{
+	"100m":  Size100m,
+	"250m":  Size250m,
+	"1km":   Size1km,
+	"10km":  Size10km,
+	"100km": Size100km,
+}
+
+// String returns the size as written in the cell identifier
+func (size Size) String() string {
+	return grid.SizeName(int64(size))
+}
+
+// valid reports whether the size is one of the sizes of DKN
+func (size Size) valid() bool {
+	known, ok := sizes[size.String()]
+	return ok && known == size
+}
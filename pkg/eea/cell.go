@@ -0,0 +1,169 @@
+package eea
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/grid"
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Cell defines a cell of the EEA reference grid by its size and the south-west corner in ETRS89-LAEA
+/*
+For the city of Skagen: "1kmE4355N3846" is the cell with the south-west corner E 4355000 N 3846000
+*/
+type Cell struct {
+	Size  Size
+	East  int64 // easting of the south-west corner in meters
+	North int64 // northing of the south-west corner in meters
+}
+
+// System is the name of the EEA reference grid
+const System = "EEA"
+
+// DefaultSize is the size used by the conversions registered with package proj
+const DefaultSize = Size1km
+
+// cellPattern matches size, easting and northing of a cell code
+var cellPattern = regexp.MustCompile(`^(\d+k?m)E(\d+)N(\d+)$`)
+
+func init() {
+	proj.Register(System, func(ll proj.LL) (proj.Coordinate, error) { return EncodeLL(ll, DefaultSize) })
+	proj.RegisterConverter(SystemLAEA, System, func(c proj.Coordinate) (proj.Coordinate, error) {
+		return Encode(c.(LAEA), DefaultSize)
+	})
+	proj.RegisterConverter(System, SystemLAEA, func(c proj.Coordinate) (proj.Coordinate, error) {
+		return c.(Cell).Center(), nil
+	})
+	proj.RegisterParser(System, func(text string) (proj.Coordinate, float64, error) {
+		cell, err := Decode(strings.TrimSpace(text))
+		if err != nil {
+			return nil, 0, err
+		}
+		return cell, 1.0, nil
+	})
+}
+
+/*
+Encode returns the cell of the given size containing the ETRS89-LAEA coordinate.
+
+	For the city of Skagen: Encode(LAEA{Northing: 3846595.43, Easting: 4355610.79}, Size1km) -> "1kmE4355N3846"
+*/
+func Encode(laea LAEA, size Size) (Cell, error) {
+
+	if !size.valid() {
+		return Cell{}, fmt.Errorf("invalid size, size = %d", size)
+	}
+	if laea.Easting < 0 || laea.Northing < 0 {
+		return Cell{}, fmt.Errorf("outside the grid, laea = %s", laea)
+	}
+
+	return Cell{Size: size, East: grid.CellCorner(laea.Easting, int64(size)), North: grid.CellCorner(laea.Northing, int64(size))}, nil
+}
+
+/*
+EncodeLL returns the cell of the given size containing ETRS89 latitude longitude.
+
+	For the city of Rønne on Bornholm: EncodeLL(proj.LL{Lat: 55.1003, Lon: 14.7065}, Size10km) -> "10kmE462N356"
+*/
+func EncodeLL(ll proj.LL, size Size) (Cell, error) {
+
+	laea, err := LAEAFromLL(ll)
+	if err != nil {
+		return Cell{}, err
+	}
+	return Encode(laea, size)
+}
+
+/*
+Decode decodes a cell code.
+
+	For the city of Skagen: "1kmE4355N3846" -> south-west corner E 4355000 N 3846000
+*/
+func Decode(code string) (Cell, error) {
+
+	m := cellPattern.FindStringSubmatch(code)
+	if m == nil {
+		return Cell{}, fmt.Errorf("bad conversion, eea = %s", code)
+	}
+	size, ok := sizes[m[1]]
+	if !ok {
+		return Cell{}, fmt.Errorf("invalid size, eea = %s", code)
+	}
+	east, errEast := strconv.ParseInt(m[2], 10, 64)
+	north, errNorth := strconv.ParseInt(m[3], 10, 64)
+	if errEast != nil || errNorth != nil {
+		return Cell{}, fmt.Errorf("bad conversion, eea = %s", code)
+	}
+
+	cell := Cell{Size: size, East: east * int64(size), North: north * int64(size)}
+	if cell.East >= 10000000 || cell.North >= 10000000 {
+		return Cell{}, fmt.Errorf("invalid cell, eea = %s", code)
+	}
+	return cell, nil
+}
+
+// SouthWest returns the south-west corner of the cell
+func (cell Cell) SouthWest() LAEA {
+	return LAEA{Northing: float64(cell.North), Easting: float64(cell.East)}
+}
+
+// NorthEast returns the north-east corner of the cell
+func (cell Cell) NorthEast() LAEA {
+	return LAEA{Northing: float64(cell.North + int64(cell.Size)), Easting: float64(cell.East + int64(cell.Size))}
+}
+
+// Center returns the centre of the cell
+func (cell Cell) Center() LAEA {
+	half := float64(cell.Size) / 2
+	return LAEA{Northing: float64(cell.North) + half, Easting: float64(cell.East) + half}
+}
+
+/*
+Polygon returns the corners of the cell in ETRS89-LAEA as a closed ring counter-clockwise from the south-west corner.
+*/
+func (cell Cell) Polygon() []LAEA {
+	polygon := make([]LAEA, 0, 5)
+	for _, corner := range grid.SquareRing(cell.East, cell.North, int64(cell.Size)) {
+		polygon = append(polygon, LAEA{Northing: float64(corner[1]), Easting: float64(corner[0])})
+	}
+	return polygon
+}
+
+/*
+PolygonLL returns the corners of the cell in latitude longitude as a closed ring counter-clockwise from the south-west corner.
+
+The sides of the cell are straight in ETRS89-LAEA, and only approximately along the meridians and parallels.
+*/
+func (cell Cell) PolygonLL() ([]proj.LL, error) {
+
+	polygon := make([]proj.LL, 0, 5)
+	for _, corner := range cell.Polygon() {
+		ll, err := corner.ToLL()
+		if err != nil {
+			return nil, fmt.Errorf("error <%v> at laea.ToLL(), laea = %s", err, corner)
+		}
+		polygon = append(polygon, ll)
+	}
+	return polygon, nil
+}
+
+// String returns the cell code
+func (cell Cell) String() string {
+	if !cell.Size.valid() {
+		return fmt.Sprintf("invalid size, size = %d", cell.Size)
+	}
+	return fmt.Sprintf("%sE%dN%d", cell.Size, cell.East/int64(cell.Size), cell.North/int64(cell.Size))
+}
+
+// System returns the name of the coordinate system
+func (cell Cell) System() string {
+	return System
+}
+
+// ToLL converts the cell to latitude longitude of its centre
+func (cell Cell) ToLL() (proj.LL, error) {
+	return cell.Center().ToLL()
+}
@@ -0,0 +1,124 @@
+package eea
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestEncode(t *testing.T) {
+
+	skagen := LAEA{Northing: 3846595.43, Easting: 4355610.79}
+
+	var tests = []struct {
+		laea LAEA   // in
+		size Size   // in
+		cell string // out
+		err  error  // out
+	}{
+		// positive tests
+		{skagen, Size100m, "100mE43556N38465", nil},
+		{skagen, Size1km, "1kmE4355N3846", nil},
+		{skagen, Size10km, "10kmE435N384", nil},
+		{skagen, Size100km, "100kmE43N38", nil},
+		{LAEA{Northing: 3846999.9999999, Easting: 4355999.9999999}, Size1km, "1kmE4356N3847", nil},
+		// negative tests
+		{skagen, Size(250), "invalid size, size = 0", fmt.Errorf("invalid size, size = 250")},
+		{LAEA{Northing: -1, Easting: 4355610.79}, Size1km, "invalid size, size = 0", fmt.Errorf("outside the grid, laea = LAEA N -1.00 E 4355610.79")},
+	}
+
+	for _, test := range tests {
+		cell, err := Encode(test.laea, test.size)
+		function := fmt.Sprintf("Encode(%s, %s)", test.laea, test.size)
+		got := fmt.Sprintf("%s %v", cell, err)
+		want := fmt.Sprintf("%s %v", test.cell, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestEncodeLL(t *testing.T) {
+
+	var tests = []struct {
+		ll   proj.LL // in
+		size Size    // in
+		cell string  // out
+		err  error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, Size1km, "1kmE4355N3846", nil},
+		{proj.LL{Lat: 55.1003, Lon: 14.7065}, Size10km, "10kmE462N356", nil},
+		{proj.LL{Lat: 55.6761, Lon: 12.5683}, Size100m, "100mE44826N36219", nil},
+		// negative tests
+		{proj.LL{Lat: 91, Lon: 12.5683}, Size1km, "invalid size, size = 0", fmt.Errorf("invalid latitude, lat = 91")},
+	}
+
+	for _, test := range tests {
+		cell, err := EncodeLL(test.ll, test.size)
+		function := fmt.Sprintf("EncodeLL(%s, %s)", test.ll, test.size)
+		got := fmt.Sprintf("%s %v", cell, err)
+		want := fmt.Sprintf("%s %v", test.cell, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+
+	var tests = []struct {
+		code      string // in
+		southWest string // out
+		northEast string // out
+		err       error  // out
+	}{
+		// positive tests
+		{"1kmE4321N3210", "LAEA N 3210000.00 E 4321000.00", "LAEA N 3211000.00 E 4322000.00", nil},
+		{"10kmE432N321", "LAEA N 3210000.00 E 4320000.00", "LAEA N 3220000.00 E 4330000.00", nil},
+		{"100mE43556N38465", "LAEA N 3846500.00 E 4355600.00", "LAEA N 3846600.00 E 4355700.00", nil},
+		// negative tests
+		{"1kmN3210E4321", "LAEA N 0.00 E 0.00", "LAEA N 0.00 E 0.00", fmt.Errorf("bad conversion, eea = 1kmN3210E4321")},
+		{"250mE17284N12840", "LAEA N 0.00 E 0.00", "LAEA N 0.00 E 0.00", fmt.Errorf("invalid size, eea = 250mE17284N12840")},
+		{"1kmE43210N3210", "LAEA N 0.00 E 0.00", "LAEA N 0.00 E 0.00", fmt.Errorf("invalid cell, eea = 1kmE43210N3210")},
+	}
+
+	for _, test := range tests {
+		cell, err := Decode(test.code)
+		function := fmt.Sprintf("Decode(%s)", test.code)
+		got := fmt.Sprintf("%s %s %v", cell.SouthWest(), cell.NorthEast(), err)
+		want := fmt.Sprintf("%s %s %v", test.southWest, test.northEast, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err == nil && cell.String() != test.code {
+			t.Errorf("\n%s.String() -> %s != %s\n", function, cell, test.code)
+		}
+	}
+}
+
+func TestCell_Polygon(t *testing.T) {
+
+	cell, _ := Decode("1kmE4355N3846")
+
+	got := fmt.Sprintf("%v", cell.Polygon())
+	want := "[LAEA N 3846000.00 E 4355000.00 LAEA N 3846000.00 E 4356000.00 LAEA N 3847000.00 E 4356000.00 LAEA N 3847000.00 E 4355000.00 LAEA N 3846000.00 E 4355000.00]"
+	if got != want {
+		t.Errorf("\n%s.Polygon() -> %s != %s\n", cell, got, want)
+	}
+
+	polygon, err := cell.PolygonLL()
+	got = fmt.Sprintf("%v %v", polygon, err)
+	want = "[57.714691 10.569682 57.714618 10.586437 57.723609 10.586580 57.723682 10.569821 57.714691 10.569682] <nil>"
+	if got != want {
+		t.Errorf("\n%s.PolygonLL() -> %s != %s\n", cell, got, want)
+	}
+
+	ll, err := cell.ToLL()
+	got = fmt.Sprintf("%s %v", ll, err)
+	want = "57.719150 10.578130 <nil>"
+	if got != want {
+		t.Errorf("\n%s.ToLL() -> %s != %s\n", cell, got, want)
+	}
+}
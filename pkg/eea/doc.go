@@ -0,0 +1,25 @@
+// Package eea encodes positions as cells of the European reference grid of the European Environment Agency (EEA)
+/*
+The grid divides ETRS89-LAEA (EPSG:3035), the Lambert azimuthal equal area projection centred at 52°N 10°E,
+in squares of 100 m, 1 km, 10 km and 100 km of equal area, see [proj.ETRS89LAEA].
+The code of a cell is the size followed by the easting and northing of the south-west corner divided by the size.
+
+	For the city of Skagen: "LAEA N 3846595.43 E 4355610.79"
+	- "100mE43556N38465"
+	- "1kmE4355N3846"
+	- "10kmE435N384"
+
+Statistics reported to the EU, e.g. population in the census, are aggregated on the cells of the grid.
+
+	cell, err := eea.EncodeLL(proj.LL{Lat: 57.72, Lon: 10.58}, eea.Size1km)
+	cell, err := eea.Decode("1kmE4355N3846")
+	counts, err := eea.Population(cities, eea.Size10km)
+
+The package registers the coordinate systems LAEA and EEA with package proj, see [proj.Convert] and [proj.Parse].
+
+Links:
+  - https://www.eea.europa.eu/en/datahub/datahubitem-view/3c362237-daa4-45e2-8c16-aaadfb1a003b
+  - https://inspire.ec.europa.eu/id/document/tg/gg
+  - https://epsg.io/3035
+*/
+package eea
@@ -0,0 +1,91 @@
+package eea
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// LAEA defines a coordinate of ETRS89-LAEA (EPSG:3035) in meters
+/*
+The axis order of EPSG:3035 is northing before easting.
+
+	For the city of Skagen: "LAEA N 3846595.43 E 4355610.79"
+*/
+type LAEA struct {
+	Northing float64
+	Easting  float64
+}
+
+// SystemLAEA is the name of the ETRS89-LAEA coordinate system
+const SystemLAEA = "LAEA"
+
+// laeaPattern matches the name followed by the labelled northing and easting
+var laeaPattern = regexp.MustCompile(`^(LAEA|ETRS89-LAEA|EPSG:3035)\s+N\s*(-?\d+(?:\.\d+)?)\s+E\s*(-?\d+(?:\.\d+)?)$`)
+
+func init() {
+	proj.Register(SystemLAEA, func(ll proj.LL) (proj.Coordinate, error) { return LAEAFromLL(ll) })
+	proj.RegisterParser(SystemLAEA, func(text string) (proj.Coordinate, float64, error) {
+		laea, err := ParseLAEA(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		return laea, 1.0, nil
+	})
+}
+
+/*
+LAEAFromLL projects ETRS89 latitude longitude to ETRS89-LAEA.
+
+	For the city of Skagen: LAEAFromLL(proj.LL{Lat: 57.72, Lon: 10.58}) -> "LAEA N 3846595.43 E 4355610.79"
+*/
+func LAEAFromLL(ll proj.LL) (LAEA, error) {
+
+	easting, northing, err := proj.ETRS89LAEA.Forward(ll)
+	if err != nil {
+		return LAEA{}, err
+	}
+	return LAEA{Northing: northing, Easting: easting}, nil
+}
+
+/*
+ParseLAEA parses a ETRS89-LAEA coordinate with labelled northing and easting.
+
+	For the city of Skagen: "LAEA N 3846595.43 E 4355610.79" or "EPSG:3035 N 3846595.43 E 4355610.79"
+*/
+func ParseLAEA(s string) (LAEA, error) {
+
+	normalized := strings.Join(strings.Fields(strings.ToUpper(s)), " ")
+	m := laeaPattern.FindStringSubmatch(normalized)
+	if m == nil {
+		return LAEA{}, fmt.Errorf("bad conversion, laea = %s", s)
+	}
+	northing, _ := strconv.ParseFloat(m[2], 64)
+	easting, _ := strconv.ParseFloat(m[3], 64)
+	return LAEA{Northing: northing, Easting: easting}, nil
+}
+
+// String returns the labelled northing and easting
+func (laea LAEA) String() string {
+	return fmt.Sprintf("%s N %.2f E %.2f", SystemLAEA, laea.Northing, laea.Easting)
+}
+
+// System returns the name of the coordinate system
+func (laea LAEA) System() string {
+	return SystemLAEA
+}
+
+// EPSG returns the EPSG code of ETRS89-LAEA
+func (laea LAEA) EPSG() int {
+	return 3035
+}
+
+/*
+ToLL converts ETRS89-LAEA to ETRS89 latitude longitude.
+*/
+func (laea LAEA) ToLL() (proj.LL, error) {
+	return proj.ETRS89LAEA.Inverse(laea.Easting, laea.Northing)
+}
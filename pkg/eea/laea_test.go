@@ -0,0 +1,71 @@
+package eea
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestLAEAFromLL(t *testing.T) {
+
+	var tests = []struct {
+		ll   proj.LL // in
+		laea string  // out
+		err  error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, "LAEA N 3846595.43 E 4355610.79", nil},
+		{proj.LL{Lat: 52, Lon: 10}, "LAEA N 3210000.00 E 4321000.00", nil},
+		// negative tests
+		{proj.LL{Lat: 57.72, Lon: 190}, "LAEA N 0.00 E 0.00", fmt.Errorf("invalid longitude, lon = 190")},
+	}
+
+	for _, test := range tests {
+		laea, err := LAEAFromLL(test.ll)
+		function := fmt.Sprintf("LAEAFromLL(%s)", test.ll)
+		got := fmt.Sprintf("%s %v", laea, err)
+		want := fmt.Sprintf("%s %v", test.laea, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		ll, err := laea.ToLL()
+		function = fmt.Sprintf("%s.ToLL()", laea)
+		got = fmt.Sprintf("%.8f %.8f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.8f %.8f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParseLAEA(t *testing.T) {
+
+	var tests = []struct {
+		s    string // in
+		laea string // out
+		err  error  // out
+	}{
+		// positive tests
+		{"LAEA N 3846595.43 E 4355610.79", "LAEA N 3846595.43 E 4355610.79", nil},
+		{"epsg:3035  N3846595.43 E4355610.79", "LAEA N 3846595.43 E 4355610.79", nil},
+		{"ETRS89-LAEA N 3846595 E 4355610", "LAEA N 3846595.00 E 4355610.00", nil},
+		// negative tests
+		{"LAEA E 4355610.79 N 3846595.43", "LAEA N 0.00 E 0.00", fmt.Errorf("bad conversion, laea = LAEA E 4355610.79 N 3846595.43")},
+		{"TM35FIN N 3846595.43 E 4355610.79", "LAEA N 0.00 E 0.00", fmt.Errorf("bad conversion, laea = TM35FIN N 3846595.43 E 4355610.79")},
+	}
+
+	for _, test := range tests {
+		laea, err := ParseLAEA(test.s)
+		function := fmt.Sprintf("ParseLAEA(%s)", test.s)
+		got := fmt.Sprintf("%s %v", laea, err)
+		want := fmt.Sprintf("%s %v", test.laea, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package eea
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Count defines the population of a cell
+type Count struct {
+	Cell       Cell
+	Population int64
+}
+
+/*
+Population aggregates the population of the cities on the cells of the given size.
+
+Each city counts in the cell of its position, cells without cities are left out.
+The counts are ordered from west to east and from south to north.
+
+	counts, err := eea.Population(cities, eea.Size10km) // cities built with City.BuildCity
+*/
+func Population(cities []proj.City, size Size) ([]Count, error) {
+
+	if !size.valid() {
+		return nil, fmt.Errorf("invalid size, size = %d", size)
+	}
+	totals := make(map[Cell]int64)
+	for _, city := range cities {
+		cell, err := EncodeLL(city.Geoloc, size)
+		if err != nil {
+			return nil, fmt.Errorf("error <%v> at eea.EncodeLL(), city = %s", err, city.Name)
+		}
+		totals[cell] += city.Population
+	}
+
+	counts := make([]Count, 0, len(totals))
+	for cell, population := range totals {
+		counts = append(counts, Count{Cell: cell, Population: population})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Cell.East != counts[j].Cell.East {
+			return counts[i].Cell.East < counts[j].Cell.East
+		}
+		return counts[i].Cell.North < counts[j].Cell.North
+	})
+	return counts, nil
+}
@@ -0,0 +1,44 @@
+package eea
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestPopulation(t *testing.T) {
+
+	cities := []proj.City{
+		{Name: "Skagen", Population: 7886, Geoloc: proj.LL{Lat: 57.72, Lon: 10.58}},
+		{Name: "Aarhus", Population: 290598, Geoloc: proj.LL{Lat: 56.1572, Lon: 10.2107}},
+		{Name: "Odense", Population: 182387, Geoloc: proj.LL{Lat: 55.4038, Lon: 10.4024}},
+		{Name: "Rønne", Population: 13545, Geoloc: proj.LL{Lat: 55.1003, Lon: 14.7065}},
+	}
+
+	var tests = []struct {
+		cities []proj.City // in
+		size   Size        // in
+		counts string      // out
+		err    error       // out
+	}{
+		// positive tests
+		{cities, Size100km, "[{100kmE43N35 182387} {100kmE43N36 290598} {100kmE43N38 7886} {100kmE46N35 13545}]", nil},
+		{cities, Size10km, "[{10kmE433N367 290598} {10kmE434N358 182387} {10kmE435N384 7886} {10kmE462N356 13545}]", nil},
+		{append(cities, proj.City{Name: "Hirtshals", Population: 5875, Geoloc: proj.LL{Lat: 57.59, Lon: 9.96}}), Size100km,
+			"[{100kmE43N35 182387} {100kmE43N36 290598} {100kmE43N38 13761} {100kmE46N35 13545}]", nil},
+		// negative tests
+		{cities, Size(250), "[]", fmt.Errorf("invalid size, size = 250")},
+		{[]proj.City{{Name: "Nordpolen", Geoloc: proj.LL{Lat: 91}}}, Size1km, "[]", fmt.Errorf("error <invalid latitude, lat = 91> at eea.EncodeLL(), city = Nordpolen")},
+	}
+
+	for _, test := range tests {
+		counts, err := Population(test.cities, test.size)
+		function := fmt.Sprintf("Population(%d cities, %s)", len(test.cities), test.size)
+		got := fmt.Sprintf("%v %v", counts, err)
+		want := fmt.Sprintf("%s %v", test.counts, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package eea
+
+import (
+	"github.com/brundtoe/go-geografi/pkg/grid"
+)
+
+// Size defines the side of a cell of the EEA reference grid in meters
+type Size int64
+
+const (
+	Size100m  Size = 100    // e.g. 100mE43556N38465
+	Size1km   Size = 1000   // e.g. 1kmE4355N3846
+	Size10km  Size = 10000  // e.g. 10kmE435N384
+	Size100km Size = 100000 // e.g. 100kmE43N38
+)
+
+// sizes holds the sizes of the grid by their name in the cell code
+var sizes = map[string]Size{
+	"100m":  Size100m,
+	"1km":   Size1km,
+	"10km":  Size10km,
+	"100km": Size100km,
+}
+
+// String returns the size as written in the cell code
+func (size Size) String() string {
+	return grid.SizeName(int64(size))
+}
+
+// valid reports whether the size is one of the sizes of the grid
+func (size Size) valid() bool {
+	known, ok := sizes[size.String()]
+	return ok && known == size
+}
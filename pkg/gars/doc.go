@@ -0,0 +1,21 @@
+// Package gars encodes latitude longitude as Global Area Reference System (GARS)
+/*
+GARS is used in joint operations and refines the position in three levels
+
+  - 30' cells: three digits for the longitude band 001-720 from 180°W and two letters AA-QZ for the latitude band from 90°S
+  - 15' quadrants: a digit 1-4 numbered from the north-west corner
+  - 5' areas: a digit 1-9 numbered as the keypad of a telephone from the north-west corner
+
+For example "006AG39" is the 5' area in the south-east corner of the south-west quadrant of the cell 006AG.
+
+	gars, err := gars.Encode(proj.LL{Lat: 57.72, Lon: 10.58}, gars.FiveMinute)
+	cell, err := gars.Cell()
+
+The precision is reduced or expanded with WithPrecision in the same way as MGRS.
+
+The package registers the coordinate system GARS with package proj, see [proj.Convert] and [proj.Parse].
+
+Links:
+  - https://en.wikipedia.org/wiki/Global_Area_Reference_System
+*/
+package gars
@@ -0,0 +1,163 @@
+package gars
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// GARS defines a GARS reference
+/*
+For the city of Skagen: "382NH31" is the GARS reference with precision 5'
+*/
+type GARS string
+
+// bandLetters holds the letters of the latitude bands without I and O
+const bandLetters = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// System is the name of the GARS coordinate system
+const System = "GARS"
+
+// garsPattern matches longitude band, latitude band, quadrant and keypad
+var garsPattern = regexp.MustCompile(`^(\d{3})([A-HJ-NP-Q][A-HJ-NP-Z])([1-4][1-9]?)?$`)
+
+func init() {
+	proj.Register(System, func(ll proj.LL) (proj.Coordinate, error) { return Encode(ll, FiveMinute) })
+	proj.RegisterParser(System, func(text string) (proj.Coordinate, float64, error) {
+		gars := GARS(strings.ToUpper(strings.TrimSpace(text)))
+		if _, err := gars.Cell(); err != nil {
+			return nil, 0, err
+		}
+		return gars, 0.9, nil
+	})
+}
+
+/*
+Encode encodes latitude longitude as GARS with the given precision.
+
+	For the city of Skagen: Encode(proj.LL{Lat: 57.72, Lon: 10.58}, FiveMinute) -> "382NH31"
+*/
+func Encode(ll proj.LL, precision Precision) (GARS, error) {
+
+	if precision.size() == 0 {
+		return "", fmt.Errorf("invalid precision, precision = %d", precision)
+	}
+	if _, err := ll.ToLL(); err != nil {
+		return "", err
+	}
+
+	// position in units of 5 minutes from the south-west corner of the world, the east and north edges belong to the last cell
+	lonVal := min(int(math.Floor(math.Round((ll.Lon+180)*12*1e6)/1e6)), 360*12-1)
+	latVal := min(int(math.Floor(math.Round((ll.Lat+90)*12*1e6)/1e6)), 180*12-1)
+
+	latBand := latVal / 6
+	gars := fmt.Sprintf("%03d%c%c", lonVal/6+1, bandLetters[latBand/24], bandLetters[latBand%24])
+	if precision >= FifteenMinute {
+		east, north := lonVal%6/3, latVal%6/3
+		gars += strconv.Itoa((1-north)*2 + east + 1)
+	}
+	if precision >= FiveMinute {
+		column, row := lonVal%3, latVal%3
+		gars += strconv.Itoa((2-row)*3 + column + 1)
+	}
+	return GARS(gars), nil
+}
+
+/*
+Cell returns the cell of the GARS reference.
+
+	For the city of Skagen: "382NH31" -> 57.666667 10.500000 - 57.750000 10.583333
+*/
+func (gars GARS) Cell() (Cell, error) {
+
+	s := strings.ToUpper(string(gars))
+	m := garsPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Cell{}, fmt.Errorf("bad conversion, gars = %s", gars)
+	}
+	lonBand, _ := strconv.Atoi(m[1])
+	latBand := strings.IndexByte(bandLetters, m[2][0])*24 + strings.IndexByte(bandLetters, m[2][1])
+	if lonBand < 1 || lonBand > 720 || latBand >= 360 {
+		return Cell{}, fmt.Errorf("invalid band, gars = %s", gars)
+	}
+
+	lonVal := (lonBand - 1) * 6
+	latVal := latBand * 6
+	precision := Precision(len(s))
+	if precision >= FifteenMinute {
+		quadrant := int(s[5] - '1')
+		lonVal += quadrant % 2 * 3
+		latVal += (1 - quadrant/2) * 3
+	}
+	if precision >= FiveMinute {
+		key := int(s[6] - '1')
+		lonVal += key % 3
+		latVal += 2 - key/3
+	}
+
+	size := precision.size()
+	return Cell{
+		SouthWest: proj.LL{Lat: float64(latVal)/12 - 90, Lon: float64(lonVal)/12 - 180},
+		NorthEast: proj.LL{Lat: float64(latVal+size)/12 - 90, Lon: float64(lonVal+size)/12 - 180},
+	}, nil
+}
+
+/*
+Precision returns the precision of the GARS reference.
+
+	For the city of Skagen: "382NH31" -> FiveMinute
+*/
+func (gars GARS) Precision() (Precision, error) {
+	if _, err := gars.Cell(); err != nil {
+		return 0, err
+	}
+	return Precision(len(gars)), nil
+}
+
+/*
+WithPrecision changes the precision of the GARS reference.
+
+Reducing the precision truncates the reference to the cell containing the original cell.
+Expanding the precision returns the cell at the centre of the original cell rather than the south-west corner.
+
+	For the city of Skagen: "382NH3"
+	- WithPrecision(ThirtyMinute) -> "382NH"
+	- WithPrecision(FiveMinute) -> "382NH35"
+*/
+func (gars GARS) WithPrecision(precision Precision) (GARS, error) {
+
+	cell, err := gars.Cell()
+	if err != nil {
+		return "", err
+	}
+	if precision.size() == 0 {
+		return "", fmt.Errorf("invalid precision, precision = %d", precision)
+	}
+	if precision <= Precision(len(gars)) {
+		return GARS(strings.ToUpper(string(gars))[:precision]), nil
+	}
+	return Encode(cell.Center(), precision)
+}
+
+// String returns the stringified GARS reference
+func (gars GARS) String() string {
+	return string(gars)
+}
+
+// System returns the name of the coordinate system
+func (gars GARS) System() string {
+	return System
+}
+
+// ToLL converts the GARS reference to the centre of its cell
+func (gars GARS) ToLL() (proj.LL, error) {
+	cell, err := gars.Cell()
+	if err != nil {
+		return proj.LL{}, err
+	}
+	return cell.Center(), nil
+}
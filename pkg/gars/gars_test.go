@@ -0,0 +1,167 @@
+package gars
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestEncode(t *testing.T) {
+
+	var tests = []struct {
+		ll        proj.LL   // in
+		precision Precision // in
+		gars      GARS      // out
+		err       error     // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, ThirtyMinute, "382NH", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, FifteenMinute, "382NH3", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, FiveMinute, "382NH31", nil},
+		{proj.LL{Lat: -86.958333, Lon: -177.291667}, FiveMinute, "006AG39", nil},
+		{proj.LL{Lat: 90, Lon: 180}, FiveMinute, "720QZ23", nil},
+		{proj.LL{Lat: -90, Lon: -180}, FiveMinute, "001AA37", nil},
+		// negative tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 4, "", fmt.Errorf("invalid precision, precision = 4")},
+		{proj.LL{Lat: 57.72, Lon: -190}, FiveMinute, "", fmt.Errorf("invalid longitude, lon = -190")},
+	}
+
+	for _, test := range tests {
+		gars, err := Encode(test.ll, test.precision)
+		function := fmt.Sprintf("Encode(%s, %s)", test.ll, test.precision)
+		got := fmt.Sprintf("%s %v", gars, err)
+		want := fmt.Sprintf("%s %v", test.gars, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestGARS_Cell(t *testing.T) {
+
+	var tests = []struct {
+		gars GARS  // in
+		cell Cell  // out
+		err  error // out
+	}{
+		// positive tests
+		{"006AG", Cell{proj.LL{Lat: -87, Lon: -177.5}, proj.LL{Lat: -86.5, Lon: -177}}, nil},
+		{"006AG3", Cell{proj.LL{Lat: -87, Lon: -177.5}, proj.LL{Lat: -86.75, Lon: -177.25}}, nil},
+		{"006AG39", Cell{proj.LL{Lat: -87, Lon: -177.333333}, proj.LL{Lat: -86.916667, Lon: -177.25}}, nil},
+		{"006ag1", Cell{proj.LL{Lat: -86.75, Lon: -177.5}, proj.LL{Lat: -86.5, Lon: -177.25}}, nil},
+		{"382NH31", Cell{proj.LL{Lat: 57.666667, Lon: 10.5}, proj.LL{Lat: 57.75, Lon: 10.583333}}, nil},
+		// negative tests
+		{"721AA", Cell{}, fmt.Errorf("invalid band, gars = 721AA")},
+		{"000AA", Cell{}, fmt.Errorf("invalid band, gars = 000AA")},
+		{"006RA", Cell{}, fmt.Errorf("bad conversion, gars = 006RA")},
+		{"006AG5", Cell{}, fmt.Errorf("bad conversion, gars = 006AG5")},
+		{"006AG30", Cell{}, fmt.Errorf("bad conversion, gars = 006AG30")},
+	}
+
+	for _, test := range tests {
+		cell, err := test.gars.Cell()
+		function := fmt.Sprintf("gars = %s, Cell()", test.gars)
+		got := fmt.Sprintf("%v %v", cell, err)
+		want := fmt.Sprintf("%v %v", test.cell, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestGARS_WithPrecision(t *testing.T) {
+
+	var tests = []struct {
+		gars      GARS      // in
+		precision Precision // in
+		result    GARS      // out
+		err       error     // out
+	}{
+		// positive tests
+		{"382NH31", ThirtyMinute, "382NH", nil},
+		{"382NH31", FifteenMinute, "382NH3", nil},
+		{"382NH3", FiveMinute, "382NH35", nil},
+		{"382NH", FifteenMinute, "382NH2", nil},
+		// negative tests
+		{"382NH31", 8, "", fmt.Errorf("invalid precision, precision = 8")},
+		{"382NH0", FiveMinute, "", fmt.Errorf("bad conversion, gars = 382NH0")},
+	}
+
+	for _, test := range tests {
+		result, err := test.gars.WithPrecision(test.precision)
+		function := fmt.Sprintf("gars = %s, WithPrecision(%s)", test.gars, test.precision)
+		got := fmt.Sprintf("%s %v", result, err)
+		want := fmt.Sprintf("%s %v", test.result, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestGARS_Precision(t *testing.T) {
+
+	var tests = []struct {
+		gars      GARS      // in
+		precision Precision // out
+		err       error     // out
+	}{
+		// positive tests
+		{"006AG", ThirtyMinute, nil},
+		{"006AG3", FifteenMinute, nil},
+		{"006AG39", FiveMinute, nil},
+		// negative tests
+		{"006A", 0, fmt.Errorf("bad conversion, gars = 006A")},
+	}
+
+	for _, test := range tests {
+		precision, err := test.gars.Precision()
+		function := fmt.Sprintf("gars = %s, Precision()", test.gars)
+		got := fmt.Sprintf("%s %v", precision, err)
+		want := fmt.Sprintf("%s %v", test.precision, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestCell_Polygon(t *testing.T) {
+
+	cell, _ := GARS("006AG3").Cell()
+	function := "gars = 006AG3, Cell().Polygon()"
+	got := fmt.Sprintf("%v", cell.Polygon())
+	want := "[-87.000000 -177.500000 -87.000000 -177.250000 -86.750000 -177.250000 -86.750000 -177.500000 -87.000000 -177.500000]"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+}
+
+func TestConvert(t *testing.T) {
+
+	var tests = []struct {
+		in     proj.Coordinate // in
+		system string          // in
+		out    string          // out
+	}{
+		{GARS("382NH31"), proj.SystemMGRS, "32VNJ9186197283"},
+		{proj.MGRS("32VNJ9485799059"), System, "382NH32"},
+	}
+
+	for _, test := range tests {
+		out, err := proj.ConvertTo(test.in, test.system)
+		function := fmt.Sprintf("ConvertTo(%s, %s)", test.in, test.system)
+		got := fmt.Sprintf("%v %v", out, err)
+		want := fmt.Sprintf("%s <nil>", test.out)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+
+	parsed, err := proj.Parse("006AG39")
+	function := "proj.Parse(006AG39)"
+	got := fmt.Sprintf("%s %s %.1f %v", parsed.Coordinate, parsed.System, parsed.Confidence, err)
+	want := "006AG39 GARS 0.9 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+}
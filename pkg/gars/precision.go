@@ -0,0 +1,38 @@
+package gars
+
+// Precision defines the size of a GARS cell
+type Precision int
+
+const (
+	ThirtyMinute  Precision = 5 // 30' x 30', e.g. 006AG
+	FifteenMinute Precision = 6 // 15' x 15', e.g. 006AG3
+	FiveMinute    Precision = 7 // 5' x 5', e.g. 006AG39
+)
+
+// String returns the size of the cell
+func (precision Precision) String() string {
+	switch precision {
+	case ThirtyMinute:
+		return "30'"
+	case FifteenMinute:
+		return "15'"
+	case FiveMinute:
+		return "5'"
+	default:
+		return "unknown"
+	}
+}
+
+// size returns the size of the cell in units of 5 minutes
+func (precision Precision) size() int {
+	switch precision {
+	case ThirtyMinute:
+		return 6
+	case FifteenMinute:
+		return 3
+	case FiveMinute:
+		return 1
+	default:
+		return 0
+	}
+}
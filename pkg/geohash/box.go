@@ -0,0 +1,105 @@
+package geohash
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Box defines a bounding box by its south-west and north-east corners
+/*
+A box crossing the antimeridian has a south-west longitude greater than the north-east longitude.
+*/
+type Box struct {
+	SouthWest proj.LL
+	NorthEast proj.LL
+}
+
+// MaxCover is the maximum number of geohashes returned by Cover
+const MaxCover = 10000
+
+// Center returns the centre of the box
+func (box Box) Center() proj.LL {
+	lon := (box.SouthWest.Lon + box.NorthEast.Lon) / 2
+	if box.SouthWest.Lon > box.NorthEast.Lon {
+		lon += 180
+		if lon > 180 {
+			lon -= 360
+		}
+	}
+	return proj.LL{Lat: (box.SouthWest.Lat + box.NorthEast.Lat) / 2, Lon: lon}
+}
+
+// Contains reports whether latitude longitude is within the box
+func (box Box) Contains(ll proj.LL) bool {
+	if ll.Lat < box.SouthWest.Lat || ll.Lat > box.NorthEast.Lat {
+		return false
+	}
+	if box.SouthWest.Lon > box.NorthEast.Lon {
+		return ll.Lon >= box.SouthWest.Lon || ll.Lon <= box.NorthEast.Lon
+	}
+	return ll.Lon >= box.SouthWest.Lon && ll.Lon <= box.NorthEast.Lon
+}
+
+/*
+Cover returns the geohashes with precision characters covering the box.
+
+The geohashes are ordered from south to north and from west to east.
+The number of geohashes is limited by MaxCover, choose a lower precision for large boxes.
+
+	For Copenhagen: Cover(Box{SouthWest: proj.LL{Lat: 55.6, Lon: 12.45}, NorthEast: proj.LL{Lat: 55.72, Lon: 12.65}}, 4)
+	-> "u3bu"
+*/
+func Cover(box Box, precision int) ([]Geohash, error) {
+
+	if precision < 1 || precision > MaxPrecision {
+		return nil, fmt.Errorf("invalid precision, precision = %d", precision)
+	}
+	for _, corner := range []proj.LL{box.SouthWest, box.NorthEast} {
+		if _, err := corner.ToLL(); err != nil {
+			return nil, err
+		}
+	}
+	if box.SouthWest.Lat > box.NorthEast.Lat {
+		return nil, fmt.Errorf("south above north, box = %v", box)
+	}
+
+	// the cells of a precision form a grid of rows and columns
+	latBits := 5 * precision / 2
+	lonBits := 5*precision - latBits
+	rows := 1 << latBits
+	columns := 1 << lonBits
+	height := 180.0 / float64(rows)
+	width := 360.0 / float64(columns)
+
+	row := func(lat float64) int { return min(int(math.Floor((lat+90)/height)), rows-1) }
+	column := func(lon float64) int { return min(int(math.Floor((lon+180)/width)), columns-1) }
+
+	south, north := row(box.SouthWest.Lat), row(box.NorthEast.Lat)
+	west, east := column(box.SouthWest.Lon), column(box.NorthEast.Lon)
+	if box.SouthWest.Lon > box.NorthEast.Lon {
+		east += columns
+	}
+
+	count := (north - south + 1) * (east - west + 1)
+	if count > MaxCover {
+		return nil, fmt.Errorf("too many geohashes, count = %d", count)
+	}
+
+	cover := make([]Geohash, 0, count)
+	for r := south; r <= north; r++ {
+		for c := west; c <= east; c++ {
+			center := proj.LL{
+				Lat: -90 + (float64(r)+0.5)*height,
+				Lon: -180 + (float64(c%columns)+0.5)*width,
+			}
+			g, err := Encode(center, precision)
+			if err != nil {
+				return nil, err
+			}
+			cover = append(cover, g)
+		}
+	}
+	return cover, nil
+}
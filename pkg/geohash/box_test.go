@@ -0,0 +1,61 @@
+package geohash
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestCover(t *testing.T) {
+
+	var tests = []struct {
+		box       Box       // in
+		precision int       // in
+		cover     []Geohash // out
+		err       error     // out
+	}{
+		// positive tests
+		{Box{SouthWest: proj.LL{Lat: 55.6, Lon: 12.45}, NorthEast: proj.LL{Lat: 55.72, Lon: 12.65}}, 4, []Geohash{"u3bu"}, nil},
+		{Box{SouthWest: proj.LL{Lat: 55.6, Lon: 12.45}, NorthEast: proj.LL{Lat: 55.72, Lon: 12.65}}, 5,
+			[]Geohash{"u3bu7", "u3buk", "u3bum", "u3buq", "u3bur", "u3bue", "u3bus", "u3but", "u3buw", "u3bux", "u3bug", "u3buu", "u3buv", "u3buy", "u3buz"}, nil},
+		{Box{SouthWest: proj.LL{Lat: -1, Lon: 179}, NorthEast: proj.LL{Lat: 1, Lon: -179}}, 2, []Geohash{"rz", "2p", "xb", "80"}, nil},
+		// negative tests
+		{Box{SouthWest: proj.LL{Lat: 56, Lon: 8}, NorthEast: proj.LL{Lat: 55, Lon: 9}}, 5, nil, fmt.Errorf("south above north, box = {56.000000 8.000000 55.000000 9.000000}")},
+		{Box{SouthWest: proj.LL{Lat: 54, Lon: 8}, NorthEast: proj.LL{Lat: 58, Lon: 13}}, 6, nil, fmt.Errorf("too many geohashes, count = 332424")},
+		{Box{SouthWest: proj.LL{Lat: 54, Lon: 8}, NorthEast: proj.LL{Lat: 58, Lon: 13}}, 0, nil, fmt.Errorf("invalid precision, precision = 0")},
+	}
+
+	for _, test := range tests {
+		cover, err := Cover(test.box, test.precision)
+		function := fmt.Sprintf("Cover(%v, %d)", test.box, test.precision)
+		got := fmt.Sprintf("%v %v", cover, err)
+		want := fmt.Sprintf("%v %v", test.cover, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestBox_Contains(t *testing.T) {
+
+	var tests = []struct {
+		box      Box     // in
+		ll       proj.LL // in
+		contains bool    // out
+		center   string  // out
+	}{
+		{Box{SouthWest: proj.LL{Lat: 54, Lon: 8}, NorthEast: proj.LL{Lat: 58, Lon: 13}}, proj.LL{Lat: 57.72, Lon: 10.58}, true, "56.000000 10.500000"},
+		{Box{SouthWest: proj.LL{Lat: 54, Lon: 8}, NorthEast: proj.LL{Lat: 58, Lon: 13}}, proj.LL{Lat: 55.1, Lon: 14.7}, false, "56.000000 10.500000"},
+		{Box{SouthWest: proj.LL{Lat: -1, Lon: 179}, NorthEast: proj.LL{Lat: 1, Lon: -179}}, proj.LL{Lat: 0, Lon: -179.5}, true, "0.000000 180.000000"},
+	}
+
+	for _, test := range tests {
+		function := fmt.Sprintf("box = %v, Contains(%s)", test.box, test.ll)
+		got := fmt.Sprintf("%t %s", test.box.Contains(test.ll), test.box.Center())
+		want := fmt.Sprintf("%t %s", test.contains, test.center)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Package geohash encodes latitude longitude as geohash
+/*
+A geohash interleaves the bits of longitude and latitude and encodes them in base 32.
+Each additional character divides the cell in 32, a longer geohash is a smaller cell
+
+  - 5 characters: 4.9 km x 4.9 km
+  - 7 characters: 153 m x 153 m
+  - 9 characters: 4.8 m x 4.8 m
+
+Geohashes sharing a prefix are near each other, which makes them usable as keys in caches and search indices.
+
+	g, err := geohash.Encode(proj.LL{Lat: 57.64911, Lon: 10.40744}, 11) // "u4pruydqqvj"
+	ll, latErr, lonErr, err := g.Decode()
+
+The package registers the coordinate system GEOHASH with package proj, see [proj.Convert] and [proj.Parse].
+proj.Parse is certain of a geohash with the prefix "geohash:", e.g. "geohash:u4r82g", a geohash without prefix
+must have at least 6 characters and is the least probable reading of the text.
+
+Links:
+  - https://en.wikipedia.org/wiki/Geohash
+*/
+package geohash
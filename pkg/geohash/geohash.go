@@ -0,0 +1,189 @@
+package geohash
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Geohash defines a geohash cell
+/*
+For the city of Skagen: "u4r82g" is the geohash with 6 characters (1.2 km x 0.6 km)
+*/
+type Geohash string
+
+// base32 holds the geohash alphabet, the digits and letters except a, i, l and o
+const base32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// MaxPrecision is the longest geohash, the cell is about 3.7 cm x 1.9 cm
+const MaxPrecision = 12
+
+// DefaultPrecision is used when converting from other coordinate systems, the cell is about 1.2 m x 0.6 m
+const DefaultPrecision = 10
+
+// System is the name of the geohash coordinate system
+const System = "GEOHASH"
+
+func init() {
+	proj.Register(System, func(ll proj.LL) (proj.Coordinate, error) { return Encode(ll, DefaultPrecision) })
+	proj.RegisterParser(System, parse)
+}
+
+/*
+Encode encodes latitude longitude as geohash with precision characters.
+
+	For the city of Skagen: Encode(proj.LL{Lat: 57.72, Lon: 10.58}, 6) -> "u4r82g"
+*/
+func Encode(ll proj.LL, precision int) (Geohash, error) {
+
+	if precision < 1 || precision > MaxPrecision {
+		return "", fmt.Errorf("invalid precision, precision = %d", precision)
+	}
+	if _, err := ll.ToLL(); err != nil {
+		return "", err
+	}
+
+	south, north := -90.0, 90.0
+	west, east := -180.0, 180.0
+	var sb strings.Builder
+	bit, ch := 0, 0
+	even := true
+	for sb.Len() < precision {
+		// even bits refine the longitude, odd bits the latitude
+		if even {
+			mid := (west + east) / 2
+			if ll.Lon >= mid {
+				ch = ch<<1 | 1
+				west = mid
+			} else {
+				ch <<= 1
+				east = mid
+			}
+		} else {
+			mid := (south + north) / 2
+			if ll.Lat >= mid {
+				ch = ch<<1 | 1
+				south = mid
+			} else {
+				ch <<= 1
+				north = mid
+			}
+		}
+		even = !even
+		if bit++; bit == 5 {
+			sb.WriteByte(base32[ch])
+			bit, ch = 0, 0
+		}
+	}
+	return Geohash(sb.String()), nil
+}
+
+/*
+Bounds returns the cell of the geohash.
+*/
+func (g Geohash) Bounds() (Box, error) {
+
+	if g == "" || len(g) > MaxPrecision {
+		return Box{}, fmt.Errorf("invalid geohash length, geohash = %s", g)
+	}
+
+	south, north := -90.0, 90.0
+	west, east := -180.0, 180.0
+	even := true
+	for _, r := range strings.ToLower(string(g)) {
+		ch := strings.IndexRune(base32, r)
+		if ch < 0 {
+			return Box{}, fmt.Errorf("invalid geohash character, geohash = %s", g)
+		}
+		for mask := 16; mask > 0; mask >>= 1 {
+			if even {
+				mid := (west + east) / 2
+				if ch&mask != 0 {
+					west = mid
+				} else {
+					east = mid
+				}
+			} else {
+				mid := (south + north) / 2
+				if ch&mask != 0 {
+					south = mid
+				} else {
+					north = mid
+				}
+			}
+			even = !even
+		}
+	}
+	return Box{SouthWest: proj.LL{Lat: south, Lon: west}, NorthEast: proj.LL{Lat: north, Lon: east}}, nil
+}
+
+/*
+Decode returns the centre of the geohash cell and the error bounds in degrees,
+the point encoded is within centre ± error.
+
+	For the city of Skagen: "u4r82g" -> 57.719421 10.585327 ± 0.002747 ± 0.005493
+*/
+func (g Geohash) Decode() (proj.LL, float64, float64, error) {
+
+	box, err := g.Bounds()
+	if err != nil {
+		return proj.LL{}, 0, 0, err
+	}
+	latErr := (box.NorthEast.Lat - box.SouthWest.Lat) / 2
+	lonErr := (box.NorthEast.Lon - box.SouthWest.Lon) / 2
+	return box.Center(), latErr, lonErr, nil
+}
+
+// String returns the stringified geohash
+func (g Geohash) String() string {
+	return string(g)
+}
+
+// System returns the name of the coordinate system
+func (g Geohash) System() string {
+	return System
+}
+
+// ToLL converts the geohash to the centre of its cell
+func (g Geohash) ToLL() (proj.LL, error) {
+	ll, _, _, err := g.Decode()
+	return ll, err
+}
+
+// prefix marks a geohash explicitly in text, e.g. "geohash:u4r82g"
+const prefix = "geohash:"
+
+// minParseLength is the shortest geohash recognised by proj.Parse without prefix
+const minParseLength = 6
+
+/*
+parse recognises a geohash for proj.Parse.
+
+A geohash with the prefix "geohash:" is certain. Without prefix almost any word in lower case is a valid geohash,
+so a bare geohash must have at least minParseLength characters and not only digits,
+and its confidence is below that of every other notation.
+*/
+func parse(text string) (proj.Coordinate, float64, error) {
+
+	text = strings.TrimSpace(text)
+	if len(text) > len(prefix) && strings.EqualFold(text[:len(prefix)], prefix) {
+		g := Geohash(strings.ToLower(text[len(prefix):]))
+		if _, err := g.Bounds(); err != nil {
+			return nil, 0, err
+		}
+		return g, 1.0, nil
+	}
+
+	g := Geohash(text)
+	if strings.ToLower(string(g)) != string(g) {
+		return nil, 0, fmt.Errorf("geohash not in lower case, geohash = %s", text)
+	}
+	if len(g) < minParseLength || strings.Trim(string(g), "0123456789") == "" {
+		return nil, 0, fmt.Errorf("too short or only digits, geohash = %s", text)
+	}
+	if _, err := g.Bounds(); err != nil {
+		return nil, 0, err
+	}
+	return g, 0.2, nil
+}
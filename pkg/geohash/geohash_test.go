@@ -0,0 +1,129 @@
+package geohash
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestEncode(t *testing.T) {
+
+	var tests = []struct {
+		ll        proj.LL // in
+		precision int     // in
+		geohash   Geohash // out
+		err       error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 57.64911, Lon: 10.40744}, 11, "u4pruydqqvj", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 6, "u4r82g", nil},
+		{proj.LL{Lat: 42.605, Lon: -5.603}, 5, "ezs42", nil},
+		{proj.LL{Lat: -19.887498, Lon: -43.932664}, 1, "7", nil},
+		{proj.LL{Lat: 90, Lon: 180}, 2, "zz", nil},
+		// negative tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 0, "", fmt.Errorf("invalid precision, precision = 0")},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 13, "", fmt.Errorf("invalid precision, precision = 13")},
+		{proj.LL{Lat: 95, Lon: 10.58}, 6, "", fmt.Errorf("invalid latitude, lat = 95")},
+	}
+
+	for _, test := range tests {
+		geohash, err := Encode(test.ll, test.precision)
+		function := fmt.Sprintf("Encode(%s, %d)", test.ll, test.precision)
+		got := fmt.Sprintf("%s %v", geohash, err)
+		want := fmt.Sprintf("%s %v", test.geohash, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestGeohash_Decode(t *testing.T) {
+
+	var tests = []struct {
+		geohash Geohash // in
+		ll      proj.LL // out
+		latErr  float64 // out
+		lonErr  float64 // out
+		err     error   // out
+	}{
+		// positive tests
+		{"u4pruydqqvj", proj.LL{Lat: 57.649111, Lon: 10.407440}, 0.000001, 0.000001, nil},
+		{"u4r82g", proj.LL{Lat: 57.719421, Lon: 10.585327}, 0.002747, 0.005493, nil},
+		{"EZS42", proj.LL{Lat: 42.604980, Lon: -5.603027}, 0.021973, 0.021973, nil},
+		// negative tests
+		{"", proj.LL{}, 0, 0, fmt.Errorf("invalid geohash length, geohash = ")},
+		{"u4pruydqqvjxy", proj.LL{}, 0, 0, fmt.Errorf("invalid geohash length, geohash = u4pruydqqvjxy")},
+		{"u4a", proj.LL{}, 0, 0, fmt.Errorf("invalid geohash character, geohash = u4a")},
+	}
+
+	for _, test := range tests {
+		ll, latErr, lonErr, err := test.geohash.Decode()
+		function := fmt.Sprintf("geohash = %s, Decode()", test.geohash)
+		got := fmt.Sprintf("%s %.6f %.6f %v", ll, latErr, lonErr, err)
+		want := fmt.Sprintf("%s %.6f %.6f %v", test.ll, test.latErr, test.lonErr, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+
+	var tests = []struct {
+		in     proj.Coordinate // in
+		system string          // in
+		out    string          // out
+		err    error           // out
+	}{
+		// positive tests
+		{proj.MGRS("32VNJ9485799059"), System, "u4r83h329u", nil},
+		{Geohash("u4pruydqqvj"), proj.SystemUTM, "32V 584001.26 6390517.30", nil},
+		{Geohash("u4pruydqqvj"), proj.SystemGeoURI, "geo:57.64911063015461,10.407439693808556", nil},
+		// negative tests
+		{Geohash("u4a"), proj.SystemUTM, "<nil>", fmt.Errorf("error <invalid geohash character, geohash = u4a> at conversion from GEOHASH")},
+	}
+
+	for _, test := range tests {
+		out, err := proj.ConvertTo(test.in, test.system)
+		function := fmt.Sprintf("ConvertTo(%s, %s)", test.in, test.system)
+		got := fmt.Sprintf("%v %v", out, err)
+		want := fmt.Sprintf("%s %v", test.out, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+
+	var tests = []struct {
+		text         string  // in
+		system       string  // out
+		confidence   float64 // out
+		alternatives int     // out
+		err          error   // out
+	}{
+		// positive tests
+		{"u4pruydqqvj", System, 0.2, 0, nil},
+		{"geohash:u4pruydqqvj", System, 1.0, 0, nil},
+		{"GEOHASH:U4R82G", System, 1.0, 0, nil},
+		{"geohash:u4", System, 1.0, 0, nil},
+		{"33uub162700", proj.SystemMGRS, 1.0, 1, nil},
+		// negative tests
+		{"12345", "", 0, 0, fmt.Errorf("unrecognised coordinate, text = 12345")},
+		{"123456", "", 0, 0, fmt.Errorf("unrecognised coordinate, text = 123456")},
+		{"test", "", 0, 0, fmt.Errorf("unrecognised coordinate, text = test")},
+		{"u4r82", "", 0, 0, fmt.Errorf("unrecognised coordinate, text = u4r82")},
+	}
+
+	for _, test := range tests {
+		parsed, err := proj.Parse(test.text)
+		function := fmt.Sprintf("proj.Parse(%q)", test.text)
+		got := fmt.Sprintf("%s %.1f %d %v", parsed.System, parsed.Confidence, len(parsed.Alternatives), err)
+		want := fmt.Sprintf("%s %.1f %d %v", test.system, test.confidence, test.alternatives, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package geohash
+
+import (
+	"fmt"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Direction defines the direction of a neighbouring geohash cell
+type Direction int
+
+const (
+	North Direction = iota
+	NorthEast
+	East
+	SouthEast
+	South
+	SouthWest
+	West
+	NorthWest
+)
+
+// offsets holds the number of cells north and east for each direction
+var offsets = [8][2]float64{{1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}, {0, -1}, {1, -1}}
+
+// String returns the compass abbreviation of the direction
+func (direction Direction) String() string {
+	names := [8]string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+	if direction < North || direction > NorthWest {
+		return "unknown"
+	}
+	return names[direction]
+}
+
+/*
+Neighbour returns the neighbouring geohash cell of the same precision in the given direction.
+
+The neighbours wrap around the antimeridian, there are no neighbours beyond the poles.
+
+	For the city of Skagen: "u4r82g" -> North: "u4r82u", East: "u4r835"
+*/
+func (g Geohash) Neighbour(direction Direction) (Geohash, error) {
+
+	if direction < North || direction > NorthWest {
+		return "", fmt.Errorf("invalid direction, direction = %d", direction)
+	}
+	box, err := g.Bounds()
+	if err != nil {
+		return "", err
+	}
+
+	center := box.Center()
+	lat := center.Lat + offsets[direction][0]*(box.NorthEast.Lat-box.SouthWest.Lat)
+	lon := center.Lon + offsets[direction][1]*(box.NorthEast.Lon-box.SouthWest.Lon)
+	if lat > 90 || lat < -90 {
+		return "", fmt.Errorf("no neighbour beyond the pole, geohash = %s", g)
+	}
+	if lon > 180 {
+		lon -= 360
+	} else if lon < -180 {
+		lon += 360
+	}
+	return Encode(proj.LL{Lat: lat, Lon: lon}, len(g))
+}
+
+/*
+Neighbours returns the eight neighbouring geohash cells indexed by Direction.
+
+Neighbours beyond the poles are empty.
+*/
+func (g Geohash) Neighbours() ([8]Geohash, error) {
+
+	var neighbours [8]Geohash
+	if _, err := g.Bounds(); err != nil {
+		return neighbours, err
+	}
+	for direction := North; direction <= NorthWest; direction++ {
+		neighbours[direction], _ = g.Neighbour(direction)
+	}
+	return neighbours, nil
+}
@@ -0,0 +1,59 @@
+package geohash
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGeohash_Neighbour(t *testing.T) {
+
+	var tests = []struct {
+		geohash   Geohash   // in
+		direction Direction // in
+		neighbour Geohash   // out
+		err       error     // out
+	}{
+		// positive tests
+		{"dqcjq", North, "dqcjw", nil},
+		{"dqcjq", East, "dqcjr", nil},
+		{"dqcjq", South, "dqcjn", nil},
+		{"dqcjq", West, "dqcjm", nil},
+		{"u4r82g", NorthEast, "u4r83h", nil},
+		{"0", West, "p", nil}, // wraps around the antimeridian
+		// negative tests
+		{"u", North, "", fmt.Errorf("no neighbour beyond the pole, geohash = u")},
+		{"u4a", North, "", fmt.Errorf("invalid geohash character, geohash = u4a")},
+		{"u4r82g", 8, "", fmt.Errorf("invalid direction, direction = 8")},
+	}
+
+	for _, test := range tests {
+		neighbour, err := test.geohash.Neighbour(test.direction)
+		function := fmt.Sprintf("geohash = %s, Neighbour(%s)", test.geohash, test.direction)
+		got := fmt.Sprintf("%s %v", neighbour, err)
+		want := fmt.Sprintf("%s %v", test.neighbour, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestGeohash_Neighbours(t *testing.T) {
+
+	var tests = []struct {
+		geohash    Geohash    // in
+		neighbours [8]Geohash // out
+	}{
+		{"dqcjq", [8]Geohash{"dqcjw", "dqcjx", "dqcjr", "dqcjp", "dqcjn", "dqcjj", "dqcjm", "dqcjt"}},
+		{"b", [8]Geohash{"", "", "c", "9", "8", "x", "z", ""}},
+	}
+
+	for _, test := range tests {
+		neighbours, err := test.geohash.Neighbours()
+		function := fmt.Sprintf("geohash = %s, Neighbours()", test.geohash)
+		got := fmt.Sprintf("%v %v", neighbours, err)
+		want := fmt.Sprintf("%v <nil>", test.neighbours)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
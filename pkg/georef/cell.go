@@ -0,0 +1,32 @@
+package georef
+
+import (
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Cell defines the area of a GEOREF reference by its south-west and north-east corners
+type Cell struct {
+	SouthWest proj.LL
+	NorthEast proj.LL
+}
+
+// Center returns the centre of the cell
+func (cell Cell) Center() proj.LL {
+	return proj.LL{
+		Lat: (cell.SouthWest.Lat + cell.NorthEast.Lat) / 2,
+		Lon: (cell.SouthWest.Lon + cell.NorthEast.Lon) / 2,
+	}
+}
+
+/*
+Polygon returns the corners of the cell as a closed ring counter-clockwise from the south-west corner.
+*/
+func (cell Cell) Polygon() []proj.LL {
+	return []proj.LL{
+		cell.SouthWest,
+		{Lat: cell.SouthWest.Lat, Lon: cell.NorthEast.Lon},
+		cell.NorthEast,
+		{Lat: cell.NorthEast.Lat, Lon: cell.SouthWest.Lon},
+		cell.SouthWest,
+	}
+}
@@ -0,0 +1,21 @@
+// Package georef encodes latitude longitude as World Geographic Reference System (GEOREF)
+/*
+GEOREF is used in aviation and refines the position in steps
+
+  - 15° tiles: a longitude letter A-Z and a latitude letter A-M (without I and O)
+  - 1° quadrangles: a longitude letter and a latitude letter A-Q (without I and O)
+  - minutes: longitude minutes followed by latitude minutes with 2, 3 or 4 digits each (1', 0.1' or 0.01')
+
+For example "MKPG1234" is the 1' cell with the south-west corner at 51°34'N 1°48'W.
+
+	georef, err := georef.Encode(proj.LL{Lat: 51.5667, Lon: -1.8}, georef.Minute)
+	cell, err := georef.Cell()
+
+The precision is reduced or expanded with WithPrecision in the same way as MGRS.
+
+The package registers the coordinate system GEOREF with package proj, see [proj.Convert] and [proj.Parse].
+
+Links:
+  - https://en.wikipedia.org/wiki/World_Geographic_Reference_System
+*/
+package georef
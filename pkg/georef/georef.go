@@ -0,0 +1,180 @@
+package georef
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Georef defines a GEOREF reference
+/*
+For the city of Skagen: "NKLN3443" is the GEOREF reference with precision 1'
+*/
+type Georef string
+
+const (
+	tileLongitude = "ABCDEFGHJKLMNPQRSTUVWXYZ" // 24 tiles of 15° from 180°W
+	tileLatitude  = "ABCDEFGHJKLM"             // 12 tiles of 15° from 90°S
+	quadrangle    = "ABCDEFGHJKLMNPQ"          // 15 quadrangles of 1° within the tile
+)
+
+// System is the name of the GEOREF coordinate system
+const System = "GEOREF"
+
+// georefPattern matches tile, quadrangle and an even number of 4 to 8 digits
+var georefPattern = regexp.MustCompile(`^[A-HJ-NP-Z][A-HJ-M]([A-HJ-NP-Q]{2}(\d{4}|\d{6}|\d{8})?)?$`)
+
+func init() {
+	proj.Register(System, func(ll proj.LL) (proj.Coordinate, error) { return Encode(ll, HundredthMinute) })
+	proj.RegisterParser(System, func(text string) (proj.Coordinate, float64, error) {
+		georef := Georef(strings.ToUpper(strings.TrimSpace(text)))
+		if _, err := georef.Cell(); err != nil {
+			return nil, 0, err
+		}
+		// tiles and quadrangles of letters only are rarely meant as GEOREF
+		if len(georef) <= 4 {
+			return georef, 0.3, nil
+		}
+		return georef, 0.9, nil
+	})
+}
+
+/*
+Encode encodes latitude longitude as GEOREF with the given precision.
+
+	For the city of Skagen: Encode(proj.LL{Lat: 57.72, Lon: 10.58}, Minute) -> "NKLN3443"
+*/
+func Encode(ll proj.LL, precision Precision) (Georef, error) {
+
+	if precision.size() == 0 {
+		return "", fmt.Errorf("invalid precision, precision = %d", precision)
+	}
+	if _, err := ll.ToLL(); err != nil {
+		return "", err
+	}
+
+	// position in hundredths of a minute from the south-west corner of the world, the east and north edges belong to the last cell
+	lonVal := min(int64(math.Floor(math.Round((ll.Lon+180)*6000*1e6)/1e6)), 360*6000-1)
+	latVal := min(int64(math.Floor(math.Round((ll.Lat+90)*6000*1e6)/1e6)), 180*6000-1)
+
+	var sb strings.Builder
+	sb.WriteByte(tileLongitude[lonVal/(15*6000)])
+	sb.WriteByte(tileLatitude[latVal/(15*6000)])
+	if precision >= Quadrangle {
+		sb.WriteByte(quadrangle[lonVal%(15*6000)/6000])
+		sb.WriteByte(quadrangle[latVal%(15*6000)/6000])
+	}
+	if precision >= Minute {
+		digits := int(precision-Quadrangle) / 2
+		scale := int64(math.Pow10(4 - digits))
+		format := fmt.Sprintf("%%0%dd%%0%dd", digits, digits)
+		sb.WriteString(fmt.Sprintf(format, lonVal%6000/scale, latVal%6000/scale))
+	}
+	return Georef(sb.String()), nil
+}
+
+/*
+Cell returns the cell of the GEOREF reference.
+
+	For the city of Skagen: "NKLN3443" -> 57.716667 10.566667 - 57.733333 10.583333
+*/
+func (georef Georef) Cell() (Cell, error) {
+
+	s := strings.ToUpper(string(georef))
+	if !georefPattern.MatchString(s) {
+		return Cell{}, fmt.Errorf("bad conversion, georef = %s", georef)
+	}
+	precision := Precision(len(s))
+
+	lonVal := int64(strings.IndexByte(tileLongitude, s[0])) * 15 * 6000
+	latVal := int64(strings.IndexByte(tileLatitude, s[1])) * 15 * 6000
+	if precision >= Quadrangle {
+		lonVal += int64(strings.IndexByte(quadrangle, s[2])) * 6000
+		latVal += int64(strings.IndexByte(quadrangle, s[3])) * 6000
+	}
+	if precision >= Minute {
+		digits := (len(s) - 4) / 2
+		scale := int64(math.Pow10(4 - digits))
+		lonMinutes, _ := strconv.ParseInt(s[4:4+digits], 10, 64)
+		latMinutes, _ := strconv.ParseInt(s[4+digits:], 10, 64)
+		if lonMinutes*scale >= 6000 || latMinutes*scale >= 6000 {
+			return Cell{}, fmt.Errorf("minutes must be less than 60, georef = %s", georef)
+		}
+		lonVal += lonMinutes * scale
+		latVal += latMinutes * scale
+	}
+
+	size := precision.size()
+	return Cell{
+		SouthWest: proj.LL{Lat: float64(latVal)/6000 - 90, Lon: float64(lonVal)/6000 - 180},
+		NorthEast: proj.LL{Lat: float64(latVal+size)/6000 - 90, Lon: float64(lonVal+size)/6000 - 180},
+	}, nil
+}
+
+/*
+Precision returns the precision of the GEOREF reference.
+
+	For the city of Skagen: "NKLN3443" -> Minute
+*/
+func (georef Georef) Precision() (Precision, error) {
+	if _, err := georef.Cell(); err != nil {
+		return 0, err
+	}
+	return Precision(len(georef)), nil
+}
+
+/*
+WithPrecision changes the precision of the GEOREF reference.
+
+Reducing the precision truncates the reference to the cell containing the original cell. The longitude minutes
+are written before the latitude minutes, so each half of the digits is truncated separately.
+Expanding the precision returns the cell at the centre of the original cell rather than the south-west corner.
+
+	For the city of Skagen: "NKLN3443"
+	- WithPrecision(Quadrangle) -> "NKLN"
+	- WithPrecision(HundredthMinute) -> "NKLN34504350"
+	- "NKLN34504350".WithPrecision(Minute) -> "NKLN3443"
+*/
+func (georef Georef) WithPrecision(precision Precision) (Georef, error) {
+
+	cell, err := georef.Cell()
+	if err != nil {
+		return "", err
+	}
+	if precision.size() == 0 {
+		return "", fmt.Errorf("invalid precision, precision = %d", precision)
+	}
+	if precision <= Precision(len(georef)) {
+		s := strings.ToUpper(string(georef))
+		if precision <= Quadrangle {
+			return Georef(s[:precision]), nil
+		}
+		digits := (len(s) - 4) / 2
+		keep := (int(precision) - 4) / 2
+		return Georef(s[:4+keep] + s[4+digits:4+digits+keep]), nil
+	}
+	return Encode(cell.Center(), precision)
+}
+
+// String returns the stringified GEOREF reference
+func (georef Georef) String() string {
+	return string(georef)
+}
+
+// System returns the name of the coordinate system
+func (georef Georef) System() string {
+	return System
+}
+
+// ToLL converts the GEOREF reference to the centre of its cell
+func (georef Georef) ToLL() (proj.LL, error) {
+	cell, err := georef.Cell()
+	if err != nil {
+		return proj.LL{}, err
+	}
+	return cell.Center(), nil
+}
@@ -0,0 +1,175 @@
+package georef
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestEncode(t *testing.T) {
+
+	var tests = []struct {
+		ll        proj.LL   // in
+		precision Precision // in
+		georef    Georef    // out
+		err       error     // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, Tile, "NK", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, Quadrangle, "NKLN", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, Minute, "NKLN3443", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, TenthMinute, "NKLN348432", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, HundredthMinute, "NKLN34804320", nil},
+		{proj.LL{Lat: 51.5667, Lon: -1.8}, Minute, "MKPG1234", nil},
+		{proj.LL{Lat: 90, Lon: 180}, Minute, "ZMQQ5959", nil},
+		{proj.LL{Lat: -90, Lon: -180}, Minute, "AAAA0000", nil},
+		// negative tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 6, "", fmt.Errorf("invalid precision, precision = 6")},
+		{proj.LL{Lat: 95, Lon: 10.58}, Minute, "", fmt.Errorf("invalid latitude, lat = 95")},
+	}
+
+	for _, test := range tests {
+		georef, err := Encode(test.ll, test.precision)
+		function := fmt.Sprintf("Encode(%s, %s)", test.ll, test.precision)
+		got := fmt.Sprintf("%s %v", georef, err)
+		want := fmt.Sprintf("%s %v", test.georef, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestGeoref_Cell(t *testing.T) {
+
+	var tests = []struct {
+		georef Georef // in
+		cell   Cell   // out
+		err    error  // out
+	}{
+		// positive tests
+		{"MK", Cell{proj.LL{Lat: 45, Lon: -15}, proj.LL{Lat: 60, Lon: 0}}, nil},
+		{"MKPG", Cell{proj.LL{Lat: 51, Lon: -2}, proj.LL{Lat: 52, Lon: -1}}, nil},
+		{"MKPG1234", Cell{proj.LL{Lat: 51.566667, Lon: -1.8}, proj.LL{Lat: 51.583333, Lon: -1.783333}}, nil},
+		{"mkpg1234", Cell{proj.LL{Lat: 51.566667, Lon: -1.8}, proj.LL{Lat: 51.583333, Lon: -1.783333}}, nil},
+		{"NKLN34804320", Cell{proj.LL{Lat: 57.72, Lon: 10.58}, proj.LL{Lat: 57.720167, Lon: 10.580167}}, nil},
+		// negative tests
+		{"MKPG123", Cell{}, fmt.Errorf("bad conversion, georef = MKPG123")},
+		{"MNPG1234", Cell{}, fmt.Errorf("bad conversion, georef = MNPG1234")},
+		{"MKPR1234", Cell{}, fmt.Errorf("bad conversion, georef = MKPR1234")},
+		{"MKPG6034", Cell{}, fmt.Errorf("minutes must be less than 60, georef = MKPG6034")},
+	}
+
+	for _, test := range tests {
+		cell, err := test.georef.Cell()
+		function := fmt.Sprintf("georef = %s, Cell()", test.georef)
+		got := fmt.Sprintf("%v %v", cell, err)
+		want := fmt.Sprintf("%v %v", test.cell, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestGeoref_WithPrecision(t *testing.T) {
+
+	var tests = []struct {
+		georef    Georef    // in
+		precision Precision // in
+		result    Georef    // out
+		err       error     // out
+	}{
+		// positive tests
+		{"NKLN3443", Tile, "NK", nil},
+		{"NKLN3443", Quadrangle, "NKLN", nil},
+		{"NKLN3443", Minute, "NKLN3443", nil},
+		{"NKLN3443", TenthMinute, "NKLN345435", nil},
+		{"NKLN3443", HundredthMinute, "NKLN34504350", nil},
+		{"NKLN", Minute, "NKLN3030", nil},
+		{"NKLN34504350", Minute, "NKLN3443", nil},
+		{"NKLN34504350", TenthMinute, "NKLN345435", nil},
+		{"NKLN34564359", TenthMinute, "NKLN345435", nil},
+		{"NKLN345435", Minute, "NKLN3443", nil},
+		{"nkln34504350", Quadrangle, "NKLN", nil},
+		// negative tests
+		{"NKLN3443", 3, "", fmt.Errorf("invalid precision, precision = 3")},
+		{"NKLN344", Minute, "", fmt.Errorf("bad conversion, georef = NKLN344")},
+	}
+
+	for _, test := range tests {
+		result, err := test.georef.WithPrecision(test.precision)
+		function := fmt.Sprintf("georef = %s, WithPrecision(%s)", test.georef, test.precision)
+		got := fmt.Sprintf("%s %v", result, err)
+		want := fmt.Sprintf("%s %v", test.result, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestGeoref_Precision(t *testing.T) {
+
+	var tests = []struct {
+		georef    Georef    // in
+		precision Precision // out
+		err       error     // out
+	}{
+		// positive tests
+		{"MK", Tile, nil},
+		{"MKPG1234", Minute, nil},
+		{"NKLN34804320", HundredthMinute, nil},
+		// negative tests
+		{"MKPG12", 0, fmt.Errorf("bad conversion, georef = MKPG12")},
+	}
+
+	for _, test := range tests {
+		precision, err := test.georef.Precision()
+		function := fmt.Sprintf("georef = %s, Precision()", test.georef)
+		got := fmt.Sprintf("%s %v", precision, err)
+		want := fmt.Sprintf("%s %v", test.precision, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestCell_Polygon(t *testing.T) {
+
+	cell, _ := Georef("MKPG").Cell()
+	function := "georef = MKPG, Cell().Polygon()"
+	got := fmt.Sprintf("%v", cell.Polygon())
+	want := "[51.000000 -2.000000 51.000000 -1.000000 52.000000 -1.000000 52.000000 -2.000000 51.000000 -2.000000]"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+}
+
+func TestConvert(t *testing.T) {
+
+	var tests = []struct {
+		in     proj.Coordinate // in
+		system string          // in
+		out    string          // out
+	}{
+		{Georef("MKPG1234"), proj.SystemLL, "51.575000 -1.791667"},
+		{proj.MGRS("32VNJ9485799059"), System, "NKLN35554341"},
+	}
+
+	for _, test := range tests {
+		out, err := proj.ConvertTo(test.in, test.system)
+		function := fmt.Sprintf("ConvertTo(%s, %s)", test.in, test.system)
+		got := fmt.Sprintf("%v %v", out, err)
+		want := fmt.Sprintf("%s <nil>", test.out)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+
+	parsed, err := proj.Parse("MKPG1234")
+	function := "proj.Parse(MKPG1234)"
+	got := fmt.Sprintf("%s %s %.1f %v", parsed.Coordinate, parsed.System, parsed.Confidence, err)
+	want := "MKPG1234 GEOREF 0.9 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+}
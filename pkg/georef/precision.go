@@ -0,0 +1,48 @@
+package georef
+
+// Precision defines the size of a GEOREF cell
+type Precision int
+
+const (
+	Tile            Precision = 2  // 15° x 15°, e.g. MK
+	Quadrangle      Precision = 4  // 1° x 1°, e.g. MKPG
+	Minute          Precision = 8  // 1' x 1', e.g. MKPG1234
+	TenthMinute     Precision = 10 // 0.1' x 0.1', e.g. MKPG123345
+	HundredthMinute Precision = 12 // 0.01' x 0.01', e.g. MKPG12303450
+)
+
+// String returns the size of the cell
+func (precision Precision) String() string {
+	switch precision {
+	case Tile:
+		return "15°"
+	case Quadrangle:
+		return "1°"
+	case Minute:
+		return "1'"
+	case TenthMinute:
+		return "0.1'"
+	case HundredthMinute:
+		return "0.01'"
+	default:
+		return "unknown"
+	}
+}
+
+// size returns the size of the cell in hundredths of a minute
+func (precision Precision) size() int64 {
+	switch precision {
+	case Tile:
+		return 15 * 6000
+	case Quadrangle:
+		return 6000
+	case Minute:
+		return 100
+	case TenthMinute:
+		return 10
+	case HundredthMinute:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,11 @@
+// Package grid holds the arithmetic of square cells shared by the grids of the module
+/*
+The grids DKN, the EEA reference grid and the letter squares of the British and Irish grids divide a projection
+in square cells addressed by the south-west corner. The package depends only on the standard library,
+so it may be used by every package of the module including package proj.
+
+	east := grid.CellCorner(594857.92, 1000) // 594000
+	name := grid.SizeName(250)              // "250m"
+	ring := grid.SquareRing(594000, 6399000, 1000)
+*/
+package grid
@@ -0,0 +1,38 @@
+package grid
+
+import (
+	"fmt"
+	"math"
+)
+
+// FloorMeters returns the coordinate in meters rounded down to whole meters
+/*
+The coordinate is rounded to millimeters first, as a projection may return e.g. 6399999.9999999 for a point on the line 6400000.
+*/
+func FloorMeters(v float64) float64 {
+	return math.Floor(math.Round(v*1e3) / 1e3)
+}
+
+// CellCorner returns the coordinate of the south-west corner of the square cell of the given size in meters containing v
+func CellCorner(v float64, size int64) int64 {
+	return int64(FloorMeters(v)) / size * size
+}
+
+// SizeName returns the side of a square cell in meters as written in cell codes, e.g. "250m" or "10km"
+func SizeName(size int64) string {
+	if size%1000 == 0 {
+		return fmt.Sprintf("%dkm", size/1000)
+	}
+	return fmt.Sprintf("%dm", size)
+}
+
+// SquareRing returns easting and northing of the corners of a square cell as a closed ring counter-clockwise from the south-west corner
+func SquareRing(east, north, size int64) [5][2]int64 {
+	return [5][2]int64{
+		{east, north},
+		{east + size, north},
+		{east + size, north + size},
+		{east, north + size},
+		{east, north},
+	}
+}
@@ -0,0 +1,56 @@
+package grid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCellCorner(t *testing.T) {
+
+	var tests = []struct {
+		v      float64 // in
+		size   int64   // in
+		corner int64   // out
+	}{
+		{594857.92, 1000, 594000},
+		{6399999.9999999, 1000, 6400000}, // on the line within a millimeter
+		{6399999.998, 1000, 6399000},
+		{3846595.43, 100000, 3800000},
+		{594857.92, 250, 594750},
+	}
+
+	for _, test := range tests {
+		got := CellCorner(test.v, test.size)
+		if got != test.corner {
+			t.Errorf("\n%s -> %d != %d\n", fmt.Sprintf("CellCorner(%v, %d)", test.v, test.size), got, test.corner)
+		}
+	}
+}
+
+func TestSizeName(t *testing.T) {
+
+	var tests = []struct {
+		size int64  // in
+		name string // out
+	}{
+		{100, "100m"},
+		{250, "250m"},
+		{1000, "1km"},
+		{100000, "100km"},
+	}
+
+	for _, test := range tests {
+		if got := SizeName(test.size); got != test.name {
+			t.Errorf("\n%s -> %s != %s\n", fmt.Sprintf("SizeName(%d)", test.size), got, test.name)
+		}
+	}
+}
+
+func TestSquareRing(t *testing.T) {
+
+	got := fmt.Sprint(SquareRing(594000, 6399000, 1000))
+	want := "[[594000 6399000] [595000 6399000] [595000 6400000] [594000 6400000] [594000 6399000]]"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "SquareRing(594000, 6399000, 1000)", got, want)
+	}
+}
@@ -0,0 +1,29 @@
+// Package magnetic evaluates the World Magnetic Model (WMM) offline
+/*
+The coefficients of the model are embedded from the official coefficient files published by NOAA/NCEI,
+each model is valid for five years from its epoch. The package embeds every *.COF file in its directory
+and [Declination] and [GridMagneticAngle] use the model valid at the date, see [ForDate].
+
+The embedded file is WMM2020.COF valid from 2020.0 to 2025.0, dates from 2025 onwards are rejected.
+Add the current coefficient file WMM2025.COF to the package directory to extend the embedded models,
+or load it at runtime with [Load].
+
+The model gives at a latitude longitude, height and date
+
+  - Declination: the angle from true north to magnetic north, positive east
+  - Inclination: the angle of the field below the horizontal plane, positive down
+  - Field intensity: the components X (north), Y (east), Z (down), H (horizontal) and F (total) in nT
+
+Combined with the UTM grid convergence the declination gives the grid-magnetic angle printed on topographic maps
+
+	gma, err := magnetic.GridMagneticAngle(utm, 0, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+
+For current dates load the coefficient file of the current model
+
+	model, err := magnetic.Load(file) // WMM2025.COF
+	gma, err := model.GridMagneticAngle(utm, 0, time.Now())
+
+Links:
+  - https://www.ncei.noaa.gov/products/world-magnetic-model
+*/
+package magnetic
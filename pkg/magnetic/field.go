@@ -0,0 +1,88 @@
+package magnetic
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brundtoe/go-geografi/pkg/angle"
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Field defines the magnetic field at a location and date
+/*
+	- X, Y, Z: the north, east and down components in nT
+	- H, F: the horizontal and total intensity in nT
+	- Declination: the angle from true north to magnetic north, positive east
+	- Inclination: the angle of the field below the horizontal plane, positive down
+*/
+type Field struct {
+	X           float64
+	Y           float64
+	Z           float64
+	H           float64
+	F           float64
+	Declination angle.Angle
+	Inclination angle.Angle
+}
+
+/*
+String returns the stringified Field object.
+
+	For the city of Holstebro at 2022-01-01: "D 3.23° I 70.51° F 50528.1 nT"
+*/
+func (field Field) String() string {
+	return fmt.Sprintf("D %s I %s F %.1f nT", field.Declination.Format(angle.Degree, 2), field.Inclination.Format(angle.Degree, 2), field.F)
+}
+
+/*
+Declination returns the magnetic declination at latitude longitude, height in meters above the WGS84 ellipsoid and date
+using the embedded model valid at the date.
+*/
+func Declination(ll proj.LL, height float64, date time.Time) (angle.Angle, error) {
+
+	model, err := ForDate(date)
+	if err != nil {
+		return 0, err
+	}
+	field, err := model.Field(ll, height, date)
+	if err != nil {
+		return 0, err
+	}
+	return field.Declination, nil
+}
+
+/*
+GridMagneticAngle returns the grid-magnetic angle at the UTM coordinate, height and date using the embedded model valid at the date.
+
+The grid-magnetic angle is the angle from grid north to magnetic north, positive east.
+It is the declination minus the grid convergence. A magnetic bearing is converted to a grid bearing
+by adding the grid-magnetic angle.
+*/
+func GridMagneticAngle(utm proj.UTM, height float64, date time.Time) (angle.Angle, error) {
+
+	model, err := ForDate(date)
+	if err != nil {
+		return 0, err
+	}
+	return model.GridMagneticAngle(utm, height, date)
+}
+
+/*
+GridMagneticAngle returns the grid-magnetic angle at the UTM coordinate, height and date.
+*/
+func (model Model) GridMagneticAngle(utm proj.UTM, height float64, date time.Time) (angle.Angle, error) {
+
+	ll, err := utm.ToLL()
+	if err != nil {
+		return 0, fmt.Errorf("error <%v> at utm.ToLL(), utm = %s", err, utm)
+	}
+	field, err := model.Field(ll, height, date)
+	if err != nil {
+		return 0, err
+	}
+	gamma, err := utm.Convergence()
+	if err != nil {
+		return 0, err
+	}
+	return field.Declination - gamma, nil
+}
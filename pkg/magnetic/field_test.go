@@ -0,0 +1,51 @@
+package magnetic
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestGridMagneticAngle(t *testing.T) {
+
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		utm   proj.UTM // in
+		angle float64  // out, degrees
+		err   error    // out
+	}{
+		// positive tests
+		{proj.UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, 2.657404, nil}, // Skagen
+		{proj.UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 489092.85, Northing: 6101296.46}, 3.424892, nil}, // Bredebro
+		// negative tests
+		{proj.UTM{ZoneNumber: 132, ZoneLetter: 'U', Easting: 574126, Northing: 5815291}, 0.0,
+			fmt.Errorf("error <invalid zone number, zone number = 132> at utm.ToLL(), utm = 132U 574126.00 5815291.00")},
+	}
+
+	for _, test := range tests {
+		gma, err := GridMagneticAngle(test.utm, 0, date)
+		function := fmt.Sprintf("utm = %s, GridMagneticAngle()", test.utm)
+		got := fmt.Sprintf("%.6f %v", gma.Degrees(), err)
+		want := fmt.Sprintf("%.6f %v", test.angle, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func ExampleDeclination() {
+
+	ll := proj.LL{Lat: 55.676, Lon: 12.568}
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	declination, err := Declination(ll, 0, date)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("København: %s -> declination %s\n", ll, declination)
+	// Output:
+	// København: 55.676000 12.568000 -> declination 4.562767°
+}
@@ -0,0 +1,287 @@
+package magnetic
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brundtoe/go-geografi/pkg/angle"
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// maxDegree is the degree and order of the spherical harmonic expansion of the WMM
+const maxDegree = 12
+
+// referenceRadius is the geomagnetic reference radius of the WMM in meters
+const referenceRadius = 6371200.0
+
+//go:embed *.COF
+var coefficientFiles embed.FS
+
+// models are the models of the embedded coefficient files sorted by epoch
+var models = mustLoadAll(coefficientFiles)
+
+// Model defines a World Magnetic Model loaded from a coefficient file
+/*
+	- Name: the model name, e.g. WMM-2020
+	- Epoch: the base epoch of the model as decimal year, e.g. 2020.0
+	- Released: the release date given in the coefficient file
+
+The model is valid for five years after the epoch.
+*/
+type Model struct {
+	Name     string
+	Epoch    float64
+	Released string
+	g, h     [maxDegree + 1][maxDegree + 1]float64 // Gauss coefficients in nT
+	gd, hd   [maxDegree + 1][maxDegree + 1]float64 // secular variation in nT/year
+}
+
+// Default returns the newest of the embedded models
+func Default() Model {
+	return models[len(models)-1]
+}
+
+/*
+ForDate returns the embedded model valid at the date.
+
+	ForDate(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)) -> WMM-2020
+*/
+func ForDate(date time.Time) (Model, error) {
+
+	year := decimalYear(date)
+	for i := len(models) - 1; i >= 0; i-- {
+		if year >= models[i].Epoch && year < models[i].Epoch+5 {
+			return models[i], nil
+		}
+	}
+	return Model{}, fmt.Errorf("date %s outside validity period %.1f - %.1f of the embedded models", date.Format("2006-01-02"), models[0].Epoch, Default().Epoch+5)
+}
+
+/*
+Load reads a model from a WMM coefficient file, e.g. WMM2025.COF.
+
+The first line holds epoch, model name and release date, the following lines n, m, g, h, dg/dt and dh/dt.
+*/
+func Load(r io.Reader) (Model, error) {
+
+	model := Model{}
+	scanner := bufio.NewScanner(r)
+
+	if !scanner.Scan() {
+		return Model{}, fmt.Errorf("empty coefficient file")
+	}
+	header := strings.Fields(scanner.Text())
+	if len(header) < 2 {
+		return Model{}, fmt.Errorf("invalid header, header = %s", scanner.Text())
+	}
+	epoch, err := strconv.ParseFloat(header[0], 64)
+	if err != nil {
+		return Model{}, fmt.Errorf("error <%v> at strconv.ParseFloat(), epoch = %v", err, header[0])
+	}
+	model.Epoch = epoch
+	model.Name = header[1]
+	if len(header) > 2 {
+		model.Released = header[2]
+	}
+
+	lines := 0
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 1 && strings.HasPrefix(fields[0], "9999") {
+			break
+		}
+		if len(fields) != 6 {
+			return Model{}, fmt.Errorf("invalid coefficient line, line = %s", scanner.Text())
+		}
+		n, errN := strconv.Atoi(fields[0])
+		m, errM := strconv.Atoi(fields[1])
+		if errN != nil || errM != nil || n < 1 || n > maxDegree || m < 0 || m > n {
+			return Model{}, fmt.Errorf("invalid degree or order, line = %s", scanner.Text())
+		}
+		values := [4]float64{}
+		for i := range values {
+			values[i], err = strconv.ParseFloat(fields[i+2], 64)
+			if err != nil {
+				return Model{}, fmt.Errorf("error <%v> at strconv.ParseFloat(), line = %s", err, scanner.Text())
+			}
+		}
+		model.g[n][m], model.h[n][m], model.gd[n][m], model.hd[n][m] = values[0], values[1], values[2], values[3]
+		lines++
+	}
+	if err := scanner.Err(); err != nil {
+		return Model{}, err
+	}
+	if lines == 0 {
+		return Model{}, fmt.Errorf("no coefficients in coefficient file")
+	}
+
+	return model, nil
+}
+
+// mustLoadAll loads the embedded coefficient files
+func mustLoadAll(files embed.FS) []Model {
+
+	names, err := fs.Glob(files, "*.COF")
+	if err != nil || len(names) == 0 {
+		panic("no embedded coefficient file")
+	}
+	loaded := make([]Model, 0, len(names))
+	for _, name := range names {
+		file, err := files.Open(name)
+		if err != nil {
+			panic(fmt.Sprintf("error <%v> at fs.Open() of embedded %s", err, name))
+		}
+		model, err := Load(file)
+		file.Close()
+		if err != nil {
+			panic(fmt.Sprintf("error <%v> at magnetic.Load() of embedded %s", err, name))
+		}
+		loaded = append(loaded, model)
+	}
+	sort.Slice(loaded, func(i, j int) bool { return loaded[i].Epoch < loaded[j].Epoch })
+	return loaded
+}
+
+// ValidFrom returns the start of the validity period of the model
+func (model Model) ValidFrom() time.Time {
+	return fromDecimalYear(model.Epoch)
+}
+
+// ValidTo returns the end of the validity period of the model
+func (model Model) ValidTo() time.Time {
+	return fromDecimalYear(model.Epoch + 5)
+}
+
+/*
+Field calculates the magnetic field at latitude longitude, height in meters above the WGS84 ellipsoid and date.
+
+The date must be within the validity period of the model.
+*/
+func (model Model) Field(ll proj.LL, height float64, date time.Time) (Field, error) {
+
+	if ll.Lat < -90 || ll.Lat > 90 {
+		return Field{}, fmt.Errorf("invalid latitude, lat = %v", ll.Lat)
+	}
+	if ll.Lon < -180 || ll.Lon > 180 {
+		return Field{}, fmt.Errorf("invalid longitude, lon = %v", ll.Lon)
+	}
+	year := decimalYear(date)
+	if year < model.Epoch || year >= model.Epoch+5 {
+		return Field{}, fmt.Errorf("date %s outside validity period %.1f - %.1f of %s", date.Format("2006-01-02"), model.Epoch, model.Epoch+5, model.Name)
+	}
+
+	return model.field(ll, height, year-model.Epoch), nil
+}
+
+/*
+field evaluates the spherical harmonic expansion.
+dt holds the time since the epoch of the model in years.
+*/
+func (model Model) field(ll proj.LL, height float64, dt float64) Field {
+
+	// geodetic to geocentric spherical coordinates
+	lat := angle.FromDegrees(ll.Lat).Radians()
+	lon := angle.FromDegrees(ll.Lon).Radians()
+	eccSquared := proj.WGS84.EccSquared()
+	rc := proj.WGS84.A / math.Sqrt(1-eccSquared*math.Sin(lat)*math.Sin(lat))
+	p := (rc + height) * math.Cos(lat)
+	z := (rc*(1-eccSquared) + height) * math.Sin(lat)
+	r := math.Hypot(p, z)
+	latC := math.Asin(z / r)
+
+	P, dP := legendre(math.Sin(latC))
+
+	var bx, by, bz float64
+	ratio := referenceRadius / r
+	power := ratio * ratio
+	for n := 1; n <= maxDegree; n++ {
+		power *= ratio
+		for m := 0; m <= n; m++ {
+			g := model.g[n][m] + dt*model.gd[n][m]
+			h := model.h[n][m] + dt*model.hd[n][m]
+			cos := math.Cos(float64(m) * lon)
+			sin := math.Sin(float64(m) * lon)
+			bx -= power * (g*cos + h*sin) * dP[n][m]
+			by += power * float64(m) * (g*sin - h*cos) * P[n][m]
+			bz -= power * float64(n+1) * (g*cos + h*sin) * P[n][m]
+		}
+	}
+
+	// avoid the singularity at the geographic poles
+	cosLatC := math.Cos(latC)
+	if math.Abs(cosLatC) < 1e-10 {
+		cosLatC = 1e-10
+	}
+	by /= cosLatC
+
+	// rotate from geocentric to geodetic
+	psi := latC - lat
+	field := Field{}
+	field.X = bx*math.Cos(psi) - bz*math.Sin(psi)
+	field.Y = by
+	field.Z = bx*math.Sin(psi) + bz*math.Cos(psi)
+	field.H = math.Hypot(field.X, field.Y)
+	field.F = math.Hypot(field.H, field.Z)
+	field.Declination = angle.FromRadians(math.Atan2(field.Y, field.X))
+	field.Inclination = angle.FromRadians(math.Atan2(field.Z, field.H))
+
+	return field
+}
+
+/*
+legendre calculates the Schmidt semi-normalized associated Legendre functions P[n][m] of x = sin(latitude)
+and their derivatives dP[n][m] with respect to latitude.
+*/
+func legendre(x float64) (P, dP [maxDegree + 1][maxDegree + 1]float64) {
+
+	c := math.Sqrt(1 - x*x)
+	P[0][0] = 1
+	for n := 1; n <= maxDegree; n++ {
+		for m := 0; m <= n; m++ {
+			switch {
+			case n == m:
+				norm := math.Sqrt(1 - 1/(2*float64(n)))
+				if n == 1 {
+					norm = 1
+				}
+				P[n][m] = norm * c * P[n-1][m-1]
+				dP[n][m] = norm * (c*dP[n-1][m-1] - x*P[n-1][m-1])
+			default:
+				nf, mf := float64(n), float64(m)
+				k := math.Sqrt(nf*nf - mf*mf)
+				P[n][m] = (2*nf - 1) / k * x * P[n-1][m]
+				dP[n][m] = (2*nf - 1) / k * (x*dP[n-1][m] + c*P[n-1][m])
+				if n > m+1 {
+					k2 := math.Sqrt((nf-1)*(nf-1) - mf*mf)
+					P[n][m] -= k2 / k * P[n-2][m]
+					dP[n][m] -= k2 / k * dP[n-2][m]
+				}
+			}
+		}
+	}
+	return P, dP
+}
+
+// decimalYear converts the date to a decimal year, e.g. 2020-07-02 -> 2020.5
+func decimalYear(date time.Time) float64 {
+	date = date.UTC()
+	start := time.Date(date.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	return float64(date.Year()) + date.Sub(start).Hours()/end.Sub(start).Hours()
+}
+
+// fromDecimalYear converts a decimal year to a date
+func fromDecimalYear(year float64) time.Time {
+	whole := math.Floor(year)
+	start := time.Date(int(whole), 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	return start.Add(time.Duration((year - whole) * float64(end.Sub(start))))
+}
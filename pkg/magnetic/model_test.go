@@ -0,0 +1,121 @@
+package magnetic
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestModel_Field(t *testing.T) {
+
+	// test values published with WMM2020 (WMM2020_TEST_VALUES.txt), height in km
+	var tests = []struct {
+		year        float64 // in
+		height      float64 // in
+		ll          proj.LL // in
+		declination float64 // out
+		inclination float64 // out
+		x, y, z, f  float64 // out
+	}{
+		{2020.0, 28, proj.LL{Lat: 89, Lon: -121}, -112.41, 88.46, -575.7, -1396.0, 56082.3, 56102.7},
+		{2020.0, 48, proj.LL{Lat: 80, Lon: -96}, -37.40, 88.03, 1518.0, -1160.5, 55671.9, 55704.7},
+		{2020.0, 51, proj.LL{Lat: -33, Lon: 109}, -5.78, -67.64, 21556.3, -2183.2, -52676.0, 56957.9},
+		{2020.0, 18, proj.LL{Lat: 0, Lon: 21}, 1.05, -26.46, 29311.2, 536.0, -14589.0, 32745.6},
+		{2020.5, 6, proj.LL{Lat: -36, Lon: -137}, 20.16, -52.21, 23948.6, 8791.9, -32897.6, 41630.3},
+		{2020.5, 50, proj.LL{Lat: -70, Lon: -133}, 57.40, -72.18, 8943.1, 13981.7, -51628.5, 54230.7},
+		{2021.0, 83, proj.LL{Lat: 86, Lon: -46}, -36.71, 86.83, 2408.8, -1796.2, 54184.7, 54268.0},
+		{2021.5, 12, proj.LL{Lat: -79, Lon: 115}, -136.34, -77.43, -9403.6, -8972.3, -58271.0, 59702.9},
+		{2022.0, 67, proj.LL{Lat: 72, Lon: -115}, 15.47, 85.19, 4532.7, 1254.7, 55923.4, 56120.8},
+	}
+
+	model := Default()
+	for _, test := range tests {
+		field, err := model.Field(test.ll, test.height*1000, fromDecimalYear(test.year))
+		function := fmt.Sprintf("ll = %s, Field(%.0f km, %.1f)", test.ll, test.height, test.year)
+		got := fmt.Sprintf("%.2f %.2f %.1f %.1f %.1f %.1f %v", field.Declination.Degrees(), field.Inclination.Degrees(), field.X, field.Y, field.Z, field.F, err)
+		want := fmt.Sprintf("%.2f %.2f %.1f %.1f %.1f %.1f %v", test.declination, test.inclination, test.x, test.y, test.z, test.f, nil)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestModel_FieldErrors(t *testing.T) {
+
+	model := Default()
+	after := model.ValidTo().AddDate(0, 1, 0)
+	var tests = []struct {
+		ll   proj.LL   // in
+		date time.Time // in
+		err  error     // out
+	}{
+		{proj.LL{Lat: 91, Lon: 8}, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), fmt.Errorf("invalid latitude, lat = 91")},
+		{proj.LL{Lat: 56, Lon: 181}, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), fmt.Errorf("invalid longitude, lon = 181")},
+		{proj.LL{Lat: 56, Lon: 8}, after, fmt.Errorf("date %s outside validity period %.1f - %.1f of %s", after.Format("2006-01-02"), model.Epoch, model.Epoch+5, model.Name)},
+	}
+
+	for _, test := range tests {
+		_, err := model.Field(test.ll, 0, test.date)
+		got := fmt.Sprintf("%v", err)
+		want := fmt.Sprintf("%v", test.err)
+		if got != want {
+			t.Errorf("\nll = %s, Field() -> %s != %s\n", test.ll, got, want)
+		}
+	}
+}
+
+func TestForDate(t *testing.T) {
+
+	after := Default().ValidTo().AddDate(0, 1, 0)
+	var tests = []struct {
+		date time.Time // in
+		name string    // out
+		err  error     // out
+	}{
+		// positive tests
+		{time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), "WMM-2020", nil},
+		{time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), "WMM-2020", nil},
+		// negative tests
+		{time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC), "", fmt.Errorf("date 2019-12-31 outside validity period 2020.0 - %.1f of the embedded models", Default().Epoch+5)},
+		{after, "", fmt.Errorf("date %s outside validity period 2020.0 - %.1f of the embedded models", after.Format("2006-01-02"), Default().Epoch+5)},
+	}
+
+	for _, test := range tests {
+		model, err := ForDate(test.date)
+		got := fmt.Sprintf("%s %v", model.Name, err)
+		want := fmt.Sprintf("%s %v", test.name, test.err)
+		if got != want {
+			t.Errorf("\nForDate(%s) -> %s != %s\n", test.date.Format("2006-01-02"), got, want)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+
+	var tests = []struct {
+		file string // in
+		name string // out
+		err  error  // out
+	}{
+		// positive tests
+		{"    2020.0            WMM-2020        12/10/2019\n  1  0  -29404.5       0.0        6.7        0.0\n999999999999\n", "WMM-2020", nil},
+		// negative tests
+		{"", "", fmt.Errorf("empty coefficient file")},
+		{"x WMM\n", "", fmt.Errorf("error <strconv.ParseFloat: parsing \"x\": invalid syntax> at strconv.ParseFloat(), epoch = x")},
+		{"2020.0 WMM\n 13 0 1 2 3 4\n", "", fmt.Errorf("invalid degree or order, line =  13 0 1 2 3 4")},
+		{"2020.0 WMM\n 1 0 1 2 3\n", "", fmt.Errorf("invalid coefficient line, line =  1 0 1 2 3")},
+		{"2020.0 WMM\n999999999999\n", "", fmt.Errorf("no coefficients in coefficient file")},
+	}
+
+	for _, test := range tests {
+		model, err := Load(strings.NewReader(test.file))
+		got := fmt.Sprintf("%s %v", model.Name, err)
+		want := fmt.Sprintf("%s %v", test.name, test.err)
+		if got != want {
+			t.Errorf("\nLoad(%q) -> %s != %s\n", test.file, got, want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Package maidenhead encodes latitude longitude as Maidenhead grid locator
+/*
+The Maidenhead locator is used by radio amateurs to report positions with a few characters.
+The locator refines the position in pairs of longitude and latitude
+
+  - field: letters A-R, 20° x 10°
+  - square: digits 0-9, 2° x 1°
+  - subsquare: letters a-x, 5' x 2.5'
+  - extended square: digits 0-9, 30" x 15"
+  - extended subsquare: letters a-x, 1.25" x 0.625"
+
+A locator has 2, 4, 6, 8 or 10 characters, e.g. "JO65gq" for the subsquare of Copenhagen
+
+	locator, err := maidenhead.Encode(proj.LL{Lat: 55.676, Lon: 12.568}, 6)
+	square, err := locator.Bounds()
+
+The package registers the coordinate system MAIDENHEAD with package proj, see [proj.Convert] and [proj.Parse].
+
+Links:
+  - https://en.wikipedia.org/wiki/Maidenhead_Locator_System
+*/
+package maidenhead
@@ -0,0 +1,172 @@
+package maidenhead
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Locator defines a Maidenhead grid locator
+/*
+For the city of Skagen: "JO57gr" is the locator of the subsquare
+*/
+type Locator string
+
+// MaxLength is the longest locator, the extended subsquare of about 25 m x 20 m
+const MaxLength = 10
+
+// DefaultLength is used when converting from other coordinate systems
+const DefaultLength = 10
+
+// System is the name of the Maidenhead coordinate system
+const System = "MAIDENHEAD"
+
+// bases holds the number of divisions of each pair, field, square, subsquare, extended square and extended subsquare
+var bases = [5]int64{18, 10, 24, 10, 24}
+
+// the position is computed in integers of the extended subsquare to avoid rounding errors
+const (
+	lonCells = 10 * 24 * 10 * 24 / 20 // cells per degree of longitude, 20° per field
+	latCells = 10 * 24 * 10 * 24 / 10 // cells per degree of latitude, 10° per field
+)
+
+// locatorPattern matches a locator of 2, 4, 6, 8 or 10 characters in any case
+var locatorPattern = regexp.MustCompile(`^(?i)[A-R]{2}(\d\d([A-X]{2}(\d\d([A-X]{2})?)?)?)?$`)
+
+func init() {
+	proj.Register(System, func(ll proj.LL) (proj.Coordinate, error) { return Encode(ll, DefaultLength) })
+	proj.RegisterParser(System, func(text string) (proj.Coordinate, float64, error) {
+		locator := Locator(strings.TrimSpace(text))
+		if !locatorPattern.MatchString(string(locator)) {
+			return nil, 0, fmt.Errorf("bad conversion, locator = %s", text)
+		}
+		// a single field of two letters is rarely meant as a locator
+		if len(locator) == 2 {
+			return locator.normalize(), 0.3, nil
+		}
+		return locator.normalize(), 0.9, nil
+	})
+}
+
+/*
+Encode encodes latitude longitude as locator with length characters.
+
+	For the city of Skagen: Encode(proj.LL{Lat: 57.72, Lon: 10.58}, 6) -> "JO57gr"
+*/
+func Encode(ll proj.LL, length int) (Locator, error) {
+
+	if length < 2 || length > MaxLength || length%2 == 1 {
+		return "", fmt.Errorf("invalid locator length, length = %d", length)
+	}
+	if _, err := ll.ToLL(); err != nil {
+		return "", err
+	}
+
+	lonVal := int64(math.Floor(math.Round((ll.Lon+180)*lonCells*1e6) / 1e6))
+	latVal := int64(math.Floor(math.Round((ll.Lat+90)*latCells*1e6) / 1e6))
+	// the east and north edges belong to the last cell
+	lonVal = min(lonVal, 360*lonCells-1)
+	latVal = min(latVal, 180*latCells-1)
+
+	var pairs [5][2]int64
+	for i := len(bases) - 1; i >= 0; i-- {
+		pairs[i] = [2]int64{lonVal % bases[i], latVal % bases[i]}
+		lonVal /= bases[i]
+		latVal /= bases[i]
+	}
+
+	var sb strings.Builder
+	for i := 0; i < length/2; i++ {
+		for _, value := range pairs[i] {
+			switch i {
+			case 0:
+				sb.WriteByte(byte('A' + value))
+			case 2, 4:
+				sb.WriteByte(byte('a' + value))
+			default:
+				sb.WriteByte(byte('0' + value))
+			}
+		}
+	}
+	return Locator(sb.String()), nil
+}
+
+/*
+Bounds returns the square of the locator.
+
+	For the city of Skagen: "JO57gr" -> 57.708333 10.500000 - 57.750000 10.583333
+*/
+func (locator Locator) Bounds() (Square, error) {
+
+	if !locatorPattern.MatchString(string(locator)) {
+		return Square{}, fmt.Errorf("bad conversion, locator = %s", locator)
+	}
+	s := strings.ToUpper(string(locator))
+
+	var lonVal, latVal int64
+	lonSize, latSize := int64(360*lonCells), int64(180*latCells)
+	for i := range bases {
+		lonSize /= bases[i]
+		latSize /= bases[i]
+		if 2*i < len(s) {
+			first := byte('A')
+			if i%2 == 1 {
+				first = '0'
+			}
+			lonVal += int64(s[2*i]-first) * lonSize
+			latVal += int64(s[2*i+1]-first) * latSize
+		} else {
+			// the size of the square is the size of the last pair
+			lonSize *= bases[i]
+			latSize *= bases[i]
+			break
+		}
+	}
+
+	west := float64(lonVal)/lonCells - 180
+	south := float64(latVal)/latCells - 90
+	return Square{
+		SouthWest: proj.LL{Lat: south, Lon: west},
+		NorthEast: proj.LL{Lat: south + float64(latSize)/latCells, Lon: west + float64(lonSize)/lonCells},
+	}, nil
+}
+
+/*
+Decode returns the centre of the square of the locator.
+
+	For the city of Skagen: "JO57gr" -> 57.729167 10.541667
+*/
+func (locator Locator) Decode() (proj.LL, error) {
+	square, err := locator.Bounds()
+	if err != nil {
+		return proj.LL{}, err
+	}
+	return square.Center(), nil
+}
+
+// normalize returns the locator with upper case field and lower case subsquares
+func (locator Locator) normalize() Locator {
+	s := []byte(strings.ToLower(string(locator)))
+	for i := 0; i < min(2, len(s)); i++ {
+		s[i] -= 'a' - 'A'
+	}
+	return Locator(s)
+}
+
+// String returns the stringified locator
+func (locator Locator) String() string {
+	return string(locator)
+}
+
+// System returns the name of the coordinate system
+func (locator Locator) System() string {
+	return System
+}
+
+// ToLL converts the locator to the centre of its square
+func (locator Locator) ToLL() (proj.LL, error) {
+	return locator.Decode()
+}
@@ -0,0 +1,121 @@
+package maidenhead
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestEncode(t *testing.T) {
+
+	var tests = []struct {
+		ll      proj.LL // in
+		length  int     // in
+		locator Locator // out
+		err     error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 2, "JO", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 4, "JO57", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 6, "JO57gr", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 8, "JO57gr92", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 10, "JO57gr92ot", nil},
+		{proj.LL{Lat: 48.14666, Lon: 11.60833}, 6, "JN58td", nil},
+		{proj.LL{Lat: 38.92, Lon: -77.065}, 6, "FM18lw", nil},
+		{proj.LL{Lat: -41.2865, Lon: 174.7762}, 6, "RE78jr", nil},
+		{proj.LL{Lat: 90, Lon: 180}, 6, "RR99xx", nil},
+		{proj.LL{Lat: -90, Lon: -180}, 6, "AA00aa", nil},
+		// negative tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 5, "", fmt.Errorf("invalid locator length, length = 5")},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 12, "", fmt.Errorf("invalid locator length, length = 12")},
+		{proj.LL{Lat: 57.72, Lon: 190}, 6, "", fmt.Errorf("invalid longitude, lon = 190")},
+	}
+
+	for _, test := range tests {
+		locator, err := Encode(test.ll, test.length)
+		function := fmt.Sprintf("Encode(%s, %d)", test.ll, test.length)
+		got := fmt.Sprintf("%s %v", locator, err)
+		want := fmt.Sprintf("%s %v", test.locator, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestLocator_Bounds(t *testing.T) {
+
+	var tests = []struct {
+		locator Locator // in
+		square  Square  // out
+		center  proj.LL // out
+		err     error   // out
+	}{
+		// positive tests
+		{"JO", Square{proj.LL{Lat: 50, Lon: 0}, proj.LL{Lat: 60, Lon: 20}}, proj.LL{Lat: 55, Lon: 10}, nil},
+		{"JO65", Square{proj.LL{Lat: 55, Lon: 12}, proj.LL{Lat: 56, Lon: 14}}, proj.LL{Lat: 55.5, Lon: 13}, nil},
+		{"JO65ha", Square{proj.LL{Lat: 55, Lon: 12.583333}, proj.LL{Lat: 55.041667, Lon: 12.666667}}, proj.LL{Lat: 55.020833, Lon: 12.625}, nil},
+		{"jo65HA", Square{proj.LL{Lat: 55, Lon: 12.583333}, proj.LL{Lat: 55.041667, Lon: 12.666667}}, proj.LL{Lat: 55.020833, Lon: 12.625}, nil},
+		{"JO57gr92ot", Square{proj.LL{Lat: 57.719965, Lon: 10.579861}, proj.LL{Lat: 57.720139, Lon: 10.580208}}, proj.LL{Lat: 57.720052, Lon: 10.580035}, nil},
+		// negative tests
+		{"JS65ha", Square{}, proj.LL{}, fmt.Errorf("bad conversion, locator = JS65ha")},
+		{"JO65h", Square{}, proj.LL{}, fmt.Errorf("bad conversion, locator = JO65h")},
+		{"JO65hz", Square{}, proj.LL{}, fmt.Errorf("bad conversion, locator = JO65hz")},
+	}
+
+	for _, test := range tests {
+		square, err := test.locator.Bounds()
+		center, _ := test.locator.Decode()
+		function := fmt.Sprintf("locator = %s, Bounds() Decode()", test.locator)
+		got := fmt.Sprintf("%v %s %v", square, center, err)
+		want := fmt.Sprintf("%v %s %v", test.square, test.center, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+
+	var tests = []struct {
+		in     proj.Coordinate // in
+		system string          // in
+		out    string          // out
+	}{
+		{Locator("JO65ha"), proj.SystemMGRS, "33UUA4816899688"},
+		{proj.MGRS("32VNJ9485799059"), System, "JO57hr13cq"},
+	}
+
+	for _, test := range tests {
+		out, err := proj.ConvertTo(test.in, test.system)
+		function := fmt.Sprintf("ConvertTo(%s, %s)", test.in, test.system)
+		got := fmt.Sprintf("%v %v", out, err)
+		want := fmt.Sprintf("%s <nil>", test.out)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+
+	var tests = []struct {
+		text       string  // in
+		locator    string  // out
+		confidence float64 // out
+	}{
+		{"JO65ha", "JO65ha", 0.9},
+		{"jo65HA", "JO65ha", 0.9},
+		{"JO", "JO", 0.3},
+	}
+
+	for _, test := range tests {
+		parsed, err := proj.Parse(test.text)
+		function := fmt.Sprintf("proj.Parse(%q)", test.text)
+		got := fmt.Sprintf("%s %s %.1f %v", parsed.Coordinate, parsed.System, parsed.Confidence, err)
+		want := fmt.Sprintf("%s %s %.1f <nil>", test.locator, System, test.confidence)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
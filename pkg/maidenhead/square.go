@@ -0,0 +1,19 @@
+package maidenhead
+
+import (
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Square defines the square of a locator by its south-west and north-east corners
+type Square struct {
+	SouthWest proj.LL
+	NorthEast proj.LL
+}
+
+// Center returns the centre of the square
+func (square Square) Center() proj.LL {
+	return proj.LL{
+		Lat: (square.SouthWest.Lat + square.NorthEast.Lat) / 2,
+		Lon: (square.SouthWest.Lon + square.NorthEast.Lon) / 2,
+	}
+}
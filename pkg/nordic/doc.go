@@ -0,0 +1,23 @@
+// Package nordic converts between the Swedish, Finnish and Norwegian national grids and latitude longitude
+/*
+The grids are transverse Mercator projections on GRS80, see [proj.TransverseMercator]
+
+  - SWEREF99 TM and the 12 local zones of SWEREF99, e.g. "SWEREF99 15 45"
+  - RT90 2.5 gon V by the Gauss-Krüger parameters of Lantmäteriet directly from SWEREF99
+  - ETRS-TM35FIN, the national grid of Finland
+  - NTM zones 5 to 30, the Norwegian grids with one degree wide zones
+
+Coordinates are written with the northing first as in the Nordic countries, e.g. "SWEREF99 TM N 6580824.58 E 674647.88".
+
+	sweref, err := nordic.SWEREF99FromLL(proj.LL{Lat: 59.33, Lon: 18.07}, nordic.SWEREF99TM)
+	ntm, err := nordic.NTMFromLL(proj.LL{Lat: 59.91, Lon: 10.75}, 10)
+
+The package registers the coordinate systems SWEREF99, RT90, TM35FIN and NTM with package proj, see [proj.Convert] and [proj.Parse].
+
+Links:
+  - https://www.lantmateriet.se/en/geodata/gps-geodesi-och-swepos/reference-systems/
+  - https://epsg.io/3006
+  - https://epsg.io/3067
+  - https://epsg.io/5105
+*/
+package nordic
@@ -0,0 +1,33 @@
+package nordic
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// gridPattern matches the name of a grid followed by the labelled northing and easting
+var gridPattern = regexp.MustCompile(`^(.+?)\s+N\s*(-?\d+(?:\.\d+)?)\s+E\s*(-?\d+(?:\.\d+)?)$`)
+
+/*
+parseGrid splits a coordinate into the name of the grid, northing and easting.
+
+	"SWEREF99 TM N 6580822.00 E 674032.00" -> "SWEREF99 TM", 6580822, 674032
+*/
+func parseGrid(s string) (string, float64, float64, error) {
+
+	normalized := strings.Join(strings.Fields(strings.ToUpper(s)), " ")
+	m := gridPattern.FindStringSubmatch(normalized)
+	if m == nil {
+		return "", 0, 0, fmt.Errorf("bad conversion, grid = %s", s)
+	}
+	northing, _ := strconv.ParseFloat(m[2], 64)
+	easting, _ := strconv.ParseFloat(m[3], 64)
+	return m[1], northing, easting, nil
+}
+
+// format returns the name of the grid followed by the labelled northing and easting
+func format(name string, northing, easting float64) string {
+	return fmt.Sprintf("%s N %.2f E %.2f", name, northing, easting)
+}
@@ -0,0 +1,129 @@
+package nordic
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// NTM defines a coordinate of the Norwegian NTM zones 5 to 30
+/*
+NTM (EPSG:5105-5130) is transverse Mercator on GRS80 in one degree wide zones with central meridian at the zone number + 0.5°E,
+latitude of origin 58°N, scale factor 1, false easting 100000 and false northing 1000000.
+
+	For Oslo: "NTM10 N 1212789.51 E 113987.85"
+*/
+type NTM struct {
+	Zone     int
+	Northing float64
+	Easting  float64
+}
+
+// SystemNTM is the name of the NTM coordinate system
+const SystemNTM = "NTM"
+
+func init() {
+	proj.Register(SystemNTM, func(ll proj.LL) (proj.Coordinate, error) { return NTMFromLL(ll, NTMZone(ll)) })
+	proj.RegisterParser(SystemNTM, func(text string) (proj.Coordinate, float64, error) {
+		ntm, err := ParseNTM(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		return ntm, 1.0, nil
+	})
+}
+
+// ntmProjection returns the projection of the NTM zone
+func ntmProjection(zone int) (proj.TransverseMercator, error) {
+
+	if zone < 5 || zone > 30 {
+		return proj.TransverseMercator{}, fmt.Errorf("invalid zone number, zone number = %v", zone)
+	}
+	return proj.TransverseMercator{
+		Ellipsoid:     proj.GRS80,
+		Lon0:          float64(zone) + 0.5,
+		Lat0:          58,
+		K0:            1,
+		FalseEasting:  100000,
+		FalseNorthing: 1000000,
+	}, nil
+}
+
+/*
+NTMZone returns the NTM zone of the longitude, limited to the zones 5 to 30.
+
+	For Oslo: "59.910000 10.750000" -> 10
+*/
+func NTMZone(ll proj.LL) int {
+	return min(max(int(math.Floor(ll.Lon)), 5), 30)
+}
+
+/*
+NTMFromLL projects ETRS89 latitude longitude to the NTM zone.
+
+	For Oslo: NTMFromLL(proj.LL{Lat: 59.91, Lon: 10.75}, 10) -> "NTM10 N 1212789.51 E 113987.85"
+*/
+func NTMFromLL(ll proj.LL, zone int) (NTM, error) {
+
+	tm, err := ntmProjection(zone)
+	if err != nil {
+		return NTM{}, err
+	}
+	easting, northing, err := tm.Forward(ll)
+	if err != nil {
+		return NTM{}, err
+	}
+	return NTM{Zone: zone, Northing: northing, Easting: easting}, nil
+}
+
+/*
+ParseNTM parses a NTM coordinate with the zone name and labelled northing and easting.
+
+	For Oslo: "NTM10 N 1212789.51 E 113987.85" or "NTM 10 N 1212789.51 E 113987.85"
+*/
+func ParseNTM(s string) (NTM, error) {
+
+	name, northing, easting, err := parseGrid(s)
+	if err != nil {
+		return NTM{}, fmt.Errorf("bad conversion, ntm = %s", s)
+	}
+	number, found := strings.CutPrefix(name, "NTM")
+	zone, err := strconv.Atoi(strings.TrimSpace(number))
+	if !found || err != nil {
+		return NTM{}, fmt.Errorf("bad conversion, ntm = %s", s)
+	}
+	if _, err := ntmProjection(zone); err != nil {
+		return NTM{}, err
+	}
+	return NTM{Zone: zone, Northing: northing, Easting: easting}, nil
+}
+
+// String returns the zone name and the labelled northing and easting
+func (ntm NTM) String() string {
+	return format(fmt.Sprintf("NTM%d", ntm.Zone), ntm.Northing, ntm.Easting)
+}
+
+// System returns the name of the coordinate system
+func (ntm NTM) System() string {
+	return SystemNTM
+}
+
+// EPSG returns the EPSG code of the zone
+func (ntm NTM) EPSG() int {
+	return 5100 + ntm.Zone
+}
+
+/*
+ToLL converts NTM to ETRS89 latitude longitude.
+*/
+func (ntm NTM) ToLL() (proj.LL, error) {
+
+	tm, err := ntmProjection(ntm.Zone)
+	if err != nil {
+		return proj.LL{}, err
+	}
+	return tm.Inverse(ntm.Easting, ntm.Northing)
+}
@@ -0,0 +1,123 @@
+package nordic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestNTMFromLL(t *testing.T) {
+
+	var tests = []struct {
+		ll   proj.LL // in
+		zone int     // in
+		ntm  string  // out
+		epsg int     // out
+		err  error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 59.91, Lon: 10.75}, 10, "NTM10 N 1212789.51 E 113987.85", 5110, nil},
+		{proj.LL{Lat: 58, Lon: 10.5}, 10, "NTM10 N 1000000.00 E 100000.00", 5110, nil},
+		{proj.LL{Lat: 58, Lon: 5.5}, 5, "NTM5 N 1000000.00 E 100000.00", 5105, nil},
+		{proj.LL{Lat: 58, Lon: 30.5}, 30, "NTM30 N 1000000.00 E 100000.00", 5130, nil},
+		// negative tests
+		{proj.LL{Lat: 59.91, Lon: 10.75}, 4, "NTM0 N 0.00 E 0.00", 5100, fmt.Errorf("invalid zone number, zone number = 4")},
+		{proj.LL{Lat: 59.91, Lon: 10.75}, 31, "NTM0 N 0.00 E 0.00", 5100, fmt.Errorf("invalid zone number, zone number = 31")},
+	}
+
+	for _, test := range tests {
+		ntm, err := NTMFromLL(test.ll, test.zone)
+		function := fmt.Sprintf("NTMFromLL(%s, %d)", test.ll, test.zone)
+		got := fmt.Sprintf("%s %d %v", ntm, ntm.EPSG(), err)
+		want := fmt.Sprintf("%s %d %v", test.ntm, test.epsg, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		ll, err := ntm.ToLL()
+		function = fmt.Sprintf("%s.ToLL()", ntm)
+		got = fmt.Sprintf("%.9f %.9f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.9f %.9f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestNTM_Meridian validates the central meridian of the NTM zones against the meridian arc of UTM zone 32,
+// NTM has scale factor 1 and latitude of origin 58°N where UTM has scale factor 0.9996 and origin at the equator
+func TestNTM_Meridian(t *testing.T) {
+
+	origin, _ := proj.LL{Lat: 58, Lon: 9}.ToUTMZone(32, proj.North)
+	for _, lat := range []float64{59.91, 63.43, 69.65, 71.0} {
+		utm, _ := proj.LL{Lat: lat, Lon: 9}.ToUTMZone(32, proj.North)
+		for _, zone := range []int{5, 10, 18, 30} {
+			ntm, err := NTMFromLL(proj.LL{Lat: lat, Lon: float64(zone) + 0.5}, zone)
+
+			function := fmt.Sprintf("NTMFromLL(%.2f, %d)", lat, zone)
+			got := fmt.Sprintf("%.2f %.2f %v", ntm.Easting, ntm.Northing, err)
+			want := fmt.Sprintf("%.2f %.2f <nil>", 100000.0, 1000000+(utm.Northing-origin.Northing)/0.9996)
+			if got != want {
+				t.Errorf("\n%s -> %s != %s\n", function, got, want)
+			}
+		}
+	}
+}
+
+func TestNTMZone(t *testing.T) {
+
+	var tests = []struct {
+		ll   proj.LL // in
+		zone int     // out
+	}{
+		{proj.LL{Lat: 59.91, Lon: 10.75}, 10},
+		{proj.LL{Lat: 60.39, Lon: 5.32}, 5},
+		{proj.LL{Lat: 60.39, Lon: 4.9}, 5},
+		{proj.LL{Lat: 69.65, Lon: 18.96}, 18},
+		{proj.LL{Lat: 70.07, Lon: 31.1}, 30},
+	}
+
+	for _, test := range tests {
+		if got := NTMZone(test.ll); got != test.zone {
+			t.Errorf("\n%s -> %d != %d\n", fmt.Sprintf("NTMZone(%s)", test.ll), got, test.zone)
+		}
+	}
+}
+
+func TestParseNTM(t *testing.T) {
+
+	var tests = []struct {
+		s   string // in
+		ntm string // out
+		err error  // out
+	}{
+		// positive tests
+		{"NTM10 N 1212789.51 E 113987.85", "NTM10 N 1212789.51 E 113987.85", nil},
+		{"ntm 10 n 1212789.51 e 113987.85", "NTM10 N 1212789.51 E 113987.85", nil},
+		// negative tests
+		{"NTM31 N 1212789.51 E 113987.85", "NTM0 N 0.00 E 0.00", fmt.Errorf("invalid zone number, zone number = 31")},
+		{"NTMX N 1212789.51 E 113987.85", "NTM0 N 0.00 E 0.00", fmt.Errorf("bad conversion, ntm = NTMX N 1212789.51 E 113987.85")},
+		{"NTM10 1212789.51 113987.85", "NTM0 N 0.00 E 0.00", fmt.Errorf("bad conversion, ntm = NTM10 1212789.51 113987.85")},
+	}
+
+	for _, test := range tests {
+		ntm, err := ParseNTM(test.s)
+		function := fmt.Sprintf("ParseNTM(%s)", test.s)
+		got := fmt.Sprintf("%s %v", ntm, err)
+		want := fmt.Sprintf("%s %v", test.ntm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+
+	c, err := proj.Convert[NTM](proj.LL{Lat: 59.91, Lon: 10.75})
+	got := fmt.Sprintf("%s %v", c, err)
+	want := "NTM10 N 1212789.51 E 113987.85 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "proj.Convert[NTM]()", got, want)
+	}
+}
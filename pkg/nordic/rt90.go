@@ -0,0 +1,93 @@
+package nordic
+
+import (
+	"fmt"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// RT90 defines a coordinate of the former Swedish grid RT90 2.5 gon V
+/*
+RT90 is on the Bessel ellipsoid, but Lantmäteriet publishes Gauss-Krüger parameters on GRS80
+which projects SWEREF99 latitude longitude directly to RT90 2.5 gon V with an accuracy better than a meter:
+central meridian 15°48'22.624306"E, scale factor 1.00000561024, false easting 1500064.274 and false northing -667.711.
+
+	For Stockholm: "RT90 N 6580989.31 E 1628909.54"
+*/
+type RT90 struct {
+	Northing float64
+	Easting  float64
+}
+
+// SystemRT90 is the name of the RT90 coordinate system
+const SystemRT90 = "RT90"
+
+// rt90 is the projection of RT90 2.5 gon V from SWEREF99
+var rt90 = proj.TransverseMercator{
+	Ellipsoid:     proj.GRS80,
+	Lon0:          15 + 48.0/60 + 22.624306/3600,
+	K0:            1.00000561024,
+	FalseEasting:  1500064.274,
+	FalseNorthing: -667.711,
+}
+
+func init() {
+	proj.Register(SystemRT90, func(ll proj.LL) (proj.Coordinate, error) { return RT90FromLL(ll) })
+	proj.RegisterParser(SystemRT90, func(text string) (proj.Coordinate, float64, error) {
+		rt, err := ParseRT90(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		return rt, 1.0, nil
+	})
+}
+
+/*
+RT90FromLL projects SWEREF99 latitude longitude to RT90 2.5 gon V.
+
+	For Stockholm: RT90FromLL(proj.LL{Lat: 59.33, Lon: 18.07}) -> "RT90 N 6580989.31 E 1628909.54"
+*/
+func RT90FromLL(ll proj.LL) (RT90, error) {
+
+	easting, northing, err := rt90.Forward(ll)
+	if err != nil {
+		return RT90{}, err
+	}
+	return RT90{Northing: northing, Easting: easting}, nil
+}
+
+/*
+ParseRT90 parses a RT90 coordinate with labelled northing and easting.
+
+	For Stockholm: "RT90 N 6580989.31 E 1628909.54" or "RT90 2.5 gon V N 6580989.31 E 1628909.54"
+*/
+func ParseRT90(s string) (RT90, error) {
+
+	name, northing, easting, err := parseGrid(s)
+	if err != nil || (name != "RT90" && name != "RT90 2.5 GON V" && name != "RT 90 2.5 GON V") {
+		return RT90{}, fmt.Errorf("bad conversion, rt90 = %s", s)
+	}
+	return RT90{Northing: northing, Easting: easting}, nil
+}
+
+// String returns the labelled northing and easting
+func (rt RT90) String() string {
+	return format("RT90", rt.Northing, rt.Easting)
+}
+
+// System returns the name of the coordinate system
+func (rt RT90) System() string {
+	return SystemRT90
+}
+
+// EPSG returns the EPSG code of RT90 2.5 gon V
+func (rt RT90) EPSG() int {
+	return 3021
+}
+
+/*
+ToLL converts RT90 2.5 gon V to SWEREF99 latitude longitude.
+*/
+func (rt RT90) ToLL() (proj.LL, error) {
+	return rt90.Inverse(rt.Easting, rt.Northing)
+}
@@ -0,0 +1,70 @@
+package nordic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestRT90FromLL(t *testing.T) {
+
+	var tests = []struct {
+		ll   proj.LL // in
+		rt90 string  // out
+		err  error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 59.33, Lon: 18.07}, "RT90 N 6580989.31 E 1628909.54", nil},
+		// the origin gives the published false easting and northing of Lantmäteriet
+		{proj.LL{Lat: 0, Lon: 15 + 48.0/60 + 22.624306/3600}, "RT90 N -667.71 E 1500064.27", nil},
+		// negative tests
+		{proj.LL{Lat: -91, Lon: 18.07}, "RT90 N 0.00 E 0.00", fmt.Errorf("invalid latitude, lat = -91")},
+	}
+
+	for _, test := range tests {
+		rt, err := RT90FromLL(test.ll)
+		function := fmt.Sprintf("RT90FromLL(%s)", test.ll)
+		got := fmt.Sprintf("%s %v", rt, err)
+		want := fmt.Sprintf("%s %v", test.rt90, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		ll, err := rt.ToLL()
+		function = fmt.Sprintf("%s.ToLL()", rt)
+		got = fmt.Sprintf("%.9f %.9f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.9f %.9f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParseRT90(t *testing.T) {
+
+	var tests = []struct {
+		s    string // in
+		rt90 string // out
+		err  error  // out
+	}{
+		// positive tests
+		{"RT90 N 6580989.31 E 1628909.54", "RT90 N 6580989.31 E 1628909.54", nil},
+		{"RT90 2.5 gon V N 6580989.31 E 1628909.54", "RT90 N 6580989.31 E 1628909.54", nil},
+		// negative tests
+		{"RT90 5 gon V N 6580989.31 E 1628909.54", "RT90 N 0.00 E 0.00", fmt.Errorf("bad conversion, rt90 = RT90 5 gon V N 6580989.31 E 1628909.54")},
+	}
+
+	for _, test := range tests {
+		rt, err := ParseRT90(test.s)
+		function := fmt.Sprintf("ParseRT90(%s)", test.s)
+		got := fmt.Sprintf("%s %v", rt, err)
+		want := fmt.Sprintf("%s %v", test.rt90, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
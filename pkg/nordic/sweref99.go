@@ -0,0 +1,131 @@
+package nordic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// SWEREF99 defines a coordinate of the Swedish grid SWEREF99 TM or one of its local zones
+/*
+SWEREF99 TM (EPSG:3006) is transverse Mercator on GRS80 with central meridian 15°E, scale factor 0.9996 and false easting 500000.
+The 12 local zones (EPSG:3007-3018) have scale factor 1 and false easting 150000 and are named by their central meridian.
+
+	For Stockholm: "SWEREF99 TM N 6580824.58 E 674647.88"
+*/
+type SWEREF99 struct {
+	Zone     string // TM or the central meridian of the local zone, e.g. 15 45
+	Northing float64
+	Easting  float64
+}
+
+// SystemSWEREF99 is the name of the SWEREF99 coordinate system
+const SystemSWEREF99 = "SWEREF99"
+
+// SWEREF99TM is the zone name of the national grid
+const SWEREF99TM = "TM"
+
+// swerefZone defines a zone of SWEREF99 by its EPSG code and projection
+type swerefZone struct {
+	epsg int
+	tm   proj.TransverseMercator
+}
+
+// swerefZones holds the zones of SWEREF99 by name
+var swerefZones = map[string]swerefZone{
+	SWEREF99TM: {3006, proj.TransverseMercator{Ellipsoid: proj.GRS80, Lon0: 15, K0: 0.9996, FalseEasting: 500000}},
+	"12 00":    {3007, swerefLocal(12)},
+	"13 30":    {3008, swerefLocal(13.5)},
+	"15 00":    {3009, swerefLocal(15)},
+	"16 30":    {3010, swerefLocal(16.5)},
+	"18 00":    {3011, swerefLocal(18)},
+	"14 15":    {3012, swerefLocal(14.25)},
+	"15 45":    {3013, swerefLocal(15.75)},
+	"17 15":    {3014, swerefLocal(17.25)},
+	"18 45":    {3015, swerefLocal(18.75)},
+	"20 15":    {3016, swerefLocal(20.25)},
+	"21 45":    {3017, swerefLocal(21.75)},
+	"23 15":    {3018, swerefLocal(23.25)},
+}
+
+// swerefLocal returns the projection of a local zone of SWEREF99
+func swerefLocal(lon0 float64) proj.TransverseMercator {
+	return proj.TransverseMercator{Ellipsoid: proj.GRS80, Lon0: lon0, K0: 1, FalseEasting: 150000}
+}
+
+func init() {
+	proj.Register(SystemSWEREF99, func(ll proj.LL) (proj.Coordinate, error) { return SWEREF99FromLL(ll, SWEREF99TM) })
+	proj.RegisterParser(SystemSWEREF99, func(text string) (proj.Coordinate, float64, error) {
+		sweref, err := ParseSWEREF99(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		return sweref, 1.0, nil
+	})
+}
+
+/*
+SWEREF99FromLL projects SWEREF99 latitude longitude to the zone, TM or a local zone like "15 45".
+
+SWEREF99 and WGS84 differ by less than a meter.
+
+	For Stockholm: SWEREF99FromLL(proj.LL{Lat: 59.33, Lon: 18.07}, SWEREF99TM) -> "SWEREF99 TM N 6580824.58 E 674647.88"
+*/
+func SWEREF99FromLL(ll proj.LL, zone string) (SWEREF99, error) {
+
+	z, ok := swerefZones[zone]
+	if !ok {
+		return SWEREF99{}, fmt.Errorf("invalid zone, zone = %s", zone)
+	}
+	easting, northing, err := z.tm.Forward(ll)
+	if err != nil {
+		return SWEREF99{}, err
+	}
+	return SWEREF99{Zone: zone, Northing: northing, Easting: easting}, nil
+}
+
+/*
+ParseSWEREF99 parses a SWEREF99 coordinate with the zone name and labelled northing and easting.
+
+	For Stockholm: "SWEREF99 TM N 6580824.58 E 674647.88" or "SWEREF 99 18 00 N 6579432.51 E 153984.71"
+*/
+func ParseSWEREF99(s string) (SWEREF99, error) {
+
+	name, northing, easting, err := parseGrid(s)
+	if err != nil {
+		return SWEREF99{}, fmt.Errorf("bad conversion, sweref99 = %s", s)
+	}
+	zone, found := strings.CutPrefix(strings.Replace(name, "SWEREF 99", "SWEREF99", 1), "SWEREF99 ")
+	if _, ok := swerefZones[zone]; !found || !ok {
+		return SWEREF99{}, fmt.Errorf("bad conversion, sweref99 = %s", s)
+	}
+	return SWEREF99{Zone: zone, Northing: northing, Easting: easting}, nil
+}
+
+// String returns the zone name and the labelled northing and easting
+func (sweref SWEREF99) String() string {
+	return format("SWEREF99 "+sweref.Zone, sweref.Northing, sweref.Easting)
+}
+
+// System returns the name of the coordinate system
+func (sweref SWEREF99) System() string {
+	return SystemSWEREF99
+}
+
+// EPSG returns the EPSG code of the zone, 0 for an invalid zone
+func (sweref SWEREF99) EPSG() int {
+	return swerefZones[sweref.Zone].epsg
+}
+
+/*
+ToLL converts SWEREF99 to latitude longitude.
+*/
+func (sweref SWEREF99) ToLL() (proj.LL, error) {
+
+	z, ok := swerefZones[sweref.Zone]
+	if !ok {
+		return proj.LL{}, fmt.Errorf("invalid zone, zone = %s", sweref.Zone)
+	}
+	return z.tm.Inverse(sweref.Easting, sweref.Northing)
+}
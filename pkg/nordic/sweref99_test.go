@@ -0,0 +1,114 @@
+package nordic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestSWEREF99FromLL(t *testing.T) {
+
+	stockholm := proj.LL{Lat: 59.33, Lon: 18.07}
+	var tests = []struct {
+		ll     proj.LL // in
+		zone   string  // in
+		sweref string  // out
+		epsg   int     // out
+		err    error   // out
+	}{
+		// positive tests
+		{stockholm, SWEREF99TM, "SWEREF99 TM N 6580824.58 E 674647.88", 3006, nil},
+		{stockholm, "18 00", "SWEREF99 18 00 N 6579432.51 E 153984.71", 3011, nil},
+		{proj.LL{Lat: 58, Lon: 15.75}, "15 45", "SWEREF99 15 45 N 6431282.67 E 150000.00", 3013, nil},
+		// negative tests
+		{stockholm, "18 30", "SWEREF99  N 0.00 E 0.00", 0, fmt.Errorf("invalid zone, zone = 18 30")},
+		{proj.LL{Lat: 59.33, Lon: 118.07}, SWEREF99TM, "SWEREF99  N 0.00 E 0.00", 0, fmt.Errorf("longitude too far from the central meridian, lon = 118.07")},
+	}
+
+	for _, test := range tests {
+		sweref, err := SWEREF99FromLL(test.ll, test.zone)
+		function := fmt.Sprintf("SWEREF99FromLL(%s, %s)", test.ll, test.zone)
+		got := fmt.Sprintf("%s %d %v", sweref, sweref.EPSG(), err)
+		want := fmt.Sprintf("%s %d %v", test.sweref, test.epsg, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		ll, err := sweref.ToLL()
+		function = fmt.Sprintf("%s.ToLL()", sweref)
+		got = fmt.Sprintf("%.9f %.9f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.9f %.9f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestSWEREF99_UTM validates against UTM zone 33, which is the projection of SWEREF99 TM
+func TestSWEREF99_UTM(t *testing.T) {
+
+	for _, ll := range []proj.LL{{Lat: 59.33, Lon: 18.07}, {Lat: 55.6, Lon: 13.0}, {Lat: 67.85, Lon: 20.22}} {
+		sweref, _ := SWEREF99FromLL(ll, SWEREF99TM)
+		utm, _ := ll.ToUTMZone(33, proj.North)
+
+		function := fmt.Sprintf("SWEREF99FromLL(%s, TM)", ll)
+		got := fmt.Sprintf("%.2f %.2f", sweref.Easting, sweref.Northing)
+		want := fmt.Sprintf("%.2f %.2f", utm.Easting, utm.Northing)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestSWEREF99_Local validates a local zone against SWEREF99 TM, which share the central meridian 15°E
+func TestSWEREF99_Local(t *testing.T) {
+
+	ll := proj.LL{Lat: 62.39, Lon: 17.31}
+	tm, _ := SWEREF99FromLL(proj.LL{Lat: ll.Lat, Lon: 15.5}, SWEREF99TM)
+	local, _ := SWEREF99FromLL(proj.LL{Lat: ll.Lat, Lon: 15.5}, "15 00")
+
+	got := fmt.Sprintf("%.3f %.3f", local.Easting, local.Northing)
+	want := fmt.Sprintf("%.3f %.3f", (tm.Easting-500000)/0.9996+150000, tm.Northing/0.9996)
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "SWEREF99FromLL(15 00)", got, want)
+	}
+}
+
+func TestParseSWEREF99(t *testing.T) {
+
+	var tests = []struct {
+		s      string // in
+		sweref string // out
+		err    error  // out
+	}{
+		// positive tests
+		{"SWEREF99 TM N 6580824.58 E 674647.88", "SWEREF99 TM N 6580824.58 E 674647.88", nil},
+		{"SWEREF 99 18 00 N 6579432.51 E 153984.71", "SWEREF99 18 00 N 6579432.51 E 153984.71", nil},
+		{"sweref99 tm n6580824.58 e674647.88", "SWEREF99 TM N 6580824.58 E 674647.88", nil},
+		// negative tests
+		{"SWEREF99 19 00 N 6580824.58 E 674647.88", "SWEREF99  N 0.00 E 0.00", fmt.Errorf("bad conversion, sweref99 = SWEREF99 19 00 N 6580824.58 E 674647.88")},
+		{"SWEREF99 TM 6580824.58 674647.88", "SWEREF99  N 0.00 E 0.00", fmt.Errorf("bad conversion, sweref99 = SWEREF99 TM 6580824.58 674647.88")},
+		{"RT90 N 6580989.31 E 1628909.54", "SWEREF99  N 0.00 E 0.00", fmt.Errorf("bad conversion, sweref99 = RT90 N 6580989.31 E 1628909.54")},
+	}
+
+	for _, test := range tests {
+		sweref, err := ParseSWEREF99(test.s)
+		function := fmt.Sprintf("ParseSWEREF99(%s)", test.s)
+		got := fmt.Sprintf("%s %v", sweref, err)
+		want := fmt.Sprintf("%s %v", test.sweref, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+
+	parsed, err := proj.Parse("SWEREF99 TM N 6580824.58 E 674647.88")
+	got := fmt.Sprintf("%s %s %v %v", parsed.System, parsed.Coordinate, parsed.Ambiguous(), err)
+	want := "SWEREF99 SWEREF99 TM N 6580824.58 E 674647.88 false <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "proj.Parse()", got, want)
+	}
+}
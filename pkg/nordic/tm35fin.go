@@ -0,0 +1,86 @@
+package nordic
+
+import (
+	"fmt"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// TM35FIN defines a coordinate of the Finnish grid ETRS-TM35FIN
+/*
+ETRS-TM35FIN (EPSG:3067) is transverse Mercator on GRS80 with central meridian 27°E, scale factor 0.9996 and false easting 500000,
+the projection of UTM zone 35 extended to all of Finland.
+
+	For Helsinki: "TM35FIN N 6672126.74 E 385700.42"
+*/
+type TM35FIN struct {
+	Northing float64
+	Easting  float64
+}
+
+// SystemTM35FIN is the name of the ETRS-TM35FIN coordinate system
+const SystemTM35FIN = "TM35FIN"
+
+// tm35fin is the projection of ETRS-TM35FIN
+var tm35fin = proj.TransverseMercator{Ellipsoid: proj.GRS80, Lon0: 27, K0: 0.9996, FalseEasting: 500000}
+
+func init() {
+	proj.Register(SystemTM35FIN, func(ll proj.LL) (proj.Coordinate, error) { return TM35FINFromLL(ll) })
+	proj.RegisterParser(SystemTM35FIN, func(text string) (proj.Coordinate, float64, error) {
+		fin, err := ParseTM35FIN(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		return fin, 1.0, nil
+	})
+}
+
+/*
+TM35FINFromLL projects ETRS89 latitude longitude to ETRS-TM35FIN.
+
+	For Helsinki: TM35FINFromLL(proj.LL{Lat: 60.17, Lon: 24.94}) -> "TM35FIN N 6672126.74 E 385700.42"
+*/
+func TM35FINFromLL(ll proj.LL) (TM35FIN, error) {
+
+	easting, northing, err := tm35fin.Forward(ll)
+	if err != nil {
+		return TM35FIN{}, err
+	}
+	return TM35FIN{Northing: northing, Easting: easting}, nil
+}
+
+/*
+ParseTM35FIN parses a ETRS-TM35FIN coordinate with labelled northing and easting.
+
+	For Helsinki: "TM35FIN N 6672126.74 E 385700.42" or "ETRS-TM35FIN N 6672126.74 E 385700.42"
+*/
+func ParseTM35FIN(s string) (TM35FIN, error) {
+
+	name, northing, easting, err := parseGrid(s)
+	if err != nil || (name != "TM35FIN" && name != "ETRS-TM35FIN") {
+		return TM35FIN{}, fmt.Errorf("bad conversion, tm35fin = %s", s)
+	}
+	return TM35FIN{Northing: northing, Easting: easting}, nil
+}
+
+// String returns the labelled northing and easting
+func (fin TM35FIN) String() string {
+	return format("TM35FIN", fin.Northing, fin.Easting)
+}
+
+// System returns the name of the coordinate system
+func (fin TM35FIN) System() string {
+	return SystemTM35FIN
+}
+
+// EPSG returns the EPSG code of ETRS-TM35FIN
+func (fin TM35FIN) EPSG() int {
+	return 3067
+}
+
+/*
+ToLL converts ETRS-TM35FIN to ETRS89 latitude longitude.
+*/
+func (fin TM35FIN) ToLL() (proj.LL, error) {
+	return tm35fin.Inverse(fin.Easting, fin.Northing)
+}
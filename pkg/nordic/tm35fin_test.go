@@ -0,0 +1,85 @@
+package nordic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestTM35FINFromLL(t *testing.T) {
+
+	var tests = []struct {
+		ll      proj.LL // in
+		tm35fin string  // out
+		err     error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 60.17, Lon: 24.94}, "TM35FIN N 6672126.74 E 385700.42", nil},
+		{proj.LL{Lat: 0, Lon: 27}, "TM35FIN N 0.00 E 500000.00", nil},
+		// negative tests
+		{proj.LL{Lat: 60.17, Lon: 190}, "TM35FIN N 0.00 E 0.00", fmt.Errorf("invalid longitude, lon = 190")},
+	}
+
+	for _, test := range tests {
+		fin, err := TM35FINFromLL(test.ll)
+		function := fmt.Sprintf("TM35FINFromLL(%s)", test.ll)
+		got := fmt.Sprintf("%s %v", fin, err)
+		want := fmt.Sprintf("%s %v", test.tm35fin, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		ll, err := fin.ToLL()
+		function = fmt.Sprintf("%s.ToLL()", fin)
+		got = fmt.Sprintf("%.9f %.9f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.9f %.9f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestTM35FIN_UTM validates against UTM zone 35, which is the projection of ETRS-TM35FIN
+func TestTM35FIN_UTM(t *testing.T) {
+
+	for _, ll := range []proj.LL{{Lat: 60.17, Lon: 24.94}, {Lat: 65.01, Lon: 25.47}, {Lat: 62.6, Lon: 29.76}} {
+		fin, _ := TM35FINFromLL(ll)
+		utm, _ := ll.ToUTMZone(35, proj.North)
+
+		function := fmt.Sprintf("TM35FINFromLL(%s)", ll)
+		got := fmt.Sprintf("%.2f %.2f", fin.Easting, fin.Northing)
+		want := fmt.Sprintf("%.2f %.2f", utm.Easting, utm.Northing)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParseTM35FIN(t *testing.T) {
+
+	var tests = []struct {
+		s       string // in
+		tm35fin string // out
+		err     error  // out
+	}{
+		// positive tests
+		{"TM35FIN N 6672126.74 E 385700.42", "TM35FIN N 6672126.74 E 385700.42", nil},
+		{"ETRS-TM35FIN N 6672126.74 E 385700.42", "TM35FIN N 6672126.74 E 385700.42", nil},
+		// negative tests
+		{"TM35 N 6672126.74 E 385700.42", "TM35FIN N 0.00 E 0.00", fmt.Errorf("bad conversion, tm35fin = TM35 N 6672126.74 E 385700.42")},
+	}
+
+	for _, test := range tests {
+		fin, err := ParseTM35FIN(test.s)
+		function := fmt.Sprintf("ParseTM35FIN(%s)", test.s)
+		got := fmt.Sprintf("%s %v", fin, err)
+		want := fmt.Sprintf("%s %v", test.tm35fin, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
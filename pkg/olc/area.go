@@ -0,0 +1,20 @@
+package olc
+
+import (
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Area defines the area of a code by its south-west and north-east corners and the code length
+type Area struct {
+	SouthWest proj.LL
+	NorthEast proj.LL
+	Length    int
+}
+
+// Center returns the centre of the area, the north pole for areas at the pole
+func (area Area) Center() proj.LL {
+	return proj.LL{
+		Lat: min((area.SouthWest.Lat+area.NorthEast.Lat)/2, 90),
+		Lon: min((area.SouthWest.Lon+area.NorthEast.Lon)/2, 180),
+	}
+}
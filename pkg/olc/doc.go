@@ -0,0 +1,23 @@
+// Package olc encodes latitude longitude as Open Location Code (Plus Codes)
+/*
+A Plus Code is a grid reference of letters and digits with a '+' after the eighth character.
+
+  - full code: "9F9GPHCJ+22" (about 14 m x 14 m)
+  - short code: "PHCJ+22" relative to a reference location within about 40 km, e.g. the nearest city
+
+A full code is encoded from latitude longitude and decoded to its area
+
+	code, err := olc.Encode(proj.LL{Lat: 57.72, Lon: 10.58}, 10)
+	area, err := code.Decode()
+
+A short code is recovered relative to a reference location or the name of a city
+
+	code, err := olc.Code("PHCJ+22").RecoverNearest(city.Geoloc)
+	code, err := olc.RecoverLocality("PHCJ+22 Skagen", cities)
+
+The package registers the coordinate system PLUSCODE with package proj, see [proj.Convert] and [proj.Parse].
+
+Links:
+  - https://github.com/google/open-location-code/blob/main/Documentation/Specification/specification.md
+*/
+package olc
@@ -0,0 +1,225 @@
+package olc
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Code defines an Open Location Code, either full or short
+/*
+For the city of Skagen: "9F9GPHCJ+22" is the full code with length 10 (about 14 m x 14 m)
+*/
+type Code string
+
+const (
+	alphabet  = "23456789CFGHJMPQRVWX" // the 20 digits of the code
+	separator = '+'                    // separator after the eighth digit
+	padding   = '0'                    // padding of codes shorter than eight digits
+
+	separatorPosition = 8
+	pairCodeLength    = 10 // digits encoding latitude and longitude in pairs
+	maxCodeLength     = 15 // pair digits followed by grid digits
+	gridColumns       = 4  // columns of the grid refining the area after the pairs
+	gridRows          = 5  // rows of the grid refining the area after the pairs
+
+	// the area is computed in integers of the smallest cell to avoid rounding errors
+	pairPrecision     = 8000                 // 20^3, cells per degree of the pair digits
+	finalLatPrecision = pairPrecision * 3125 // 8000 * 5^5
+	finalLonPrecision = pairPrecision * 1024 // 8000 * 4^5
+)
+
+// DefaultLength is the code length used when converting from other coordinate systems (about 3.5 m x 2.8 m)
+const DefaultLength = 11
+
+// System is the name of the Plus Code coordinate system
+const System = "PLUSCODE"
+
+func init() {
+	proj.Register(System, func(ll proj.LL) (proj.Coordinate, error) { return Encode(ll, DefaultLength) })
+	proj.RegisterParser(System, func(text string) (proj.Coordinate, float64, error) {
+		code := Code(strings.ToUpper(strings.TrimSpace(text)))
+		if !code.IsFull() {
+			return nil, 0, fmt.Errorf("not a full plus code, code = %s", text)
+		}
+		return code, 1.0, nil
+	})
+}
+
+/*
+Encode encodes latitude longitude as a full code of the given length.
+
+Valid lengths are 2, 4, 6, 8 and 10 to 15. Length 10 is about 14 m x 14 m, each additional digit divides the area in 20.
+
+	For the city of Skagen: Encode(proj.LL{Lat: 57.72, Lon: 10.58}, 10) -> "9F9GPHCJ+22"
+*/
+func Encode(ll proj.LL, length int) (Code, error) {
+
+	if length < 2 || length > maxCodeLength || (length < pairCodeLength && length%2 == 1) {
+		return "", fmt.Errorf("invalid code length, length = %d", length)
+	}
+	if math.IsNaN(ll.Lat) || math.IsNaN(ll.Lon) {
+		return "", fmt.Errorf("invalid latitude longitude, ll = %s", ll)
+	}
+
+	// latitude and longitude as integers of the smallest cell from south-west corner of the world
+	latVal := int64(math.Floor(math.Round((min(max(ll.Lat, -90), 90)+90)*finalLatPrecision*1e6) / 1e6))
+	lonVal := int64(math.Floor(math.Round((ll.Lon+180)*finalLonPrecision*1e6) / 1e6))
+	if latVal >= 180*finalLatPrecision {
+		// the north pole is in the northernmost cell
+		latVal = 180*finalLatPrecision - 1
+	}
+	lonVal %= 360 * finalLonPrecision
+	if lonVal < 0 {
+		lonVal += 360 * finalLonPrecision
+	}
+
+	digits := make([]byte, maxCodeLength)
+	if length > pairCodeLength {
+		for i := maxCodeLength - 1; i >= pairCodeLength; i-- {
+			digits[i] = alphabet[(latVal%gridRows)*gridColumns+lonVal%gridColumns]
+			latVal /= gridRows
+			lonVal /= gridColumns
+		}
+	} else {
+		latVal /= finalLatPrecision / pairPrecision
+		lonVal /= finalLonPrecision / pairPrecision
+	}
+	for i := pairCodeLength - 1; i >= 0; i -= 2 {
+		digits[i] = alphabet[lonVal%20]
+		digits[i-1] = alphabet[latVal%20]
+		latVal /= 20
+		lonVal /= 20
+	}
+
+	if length < separatorPosition {
+		return Code(string(digits[:length]) + strings.Repeat(string(padding), separatorPosition-length) + string(separator)), nil
+	}
+	return Code(string(digits[:separatorPosition]) + string(separator) + string(digits[separatorPosition:length])), nil
+}
+
+/*
+IsValid reports whether the code is a valid full or short code.
+*/
+func (code Code) IsValid() bool {
+
+	s := strings.ToUpper(string(code))
+	position := strings.IndexByte(s, separator)
+	if position < 0 || position != strings.LastIndexByte(s, separator) || position > separatorPosition || position%2 == 1 {
+		return false
+	}
+	// a single digit after the separator is not allowed, a short code must have digits after the separator
+	if len(s)-position-1 == 1 || (position < separatorPosition && position == len(s)-1) {
+		return false
+	}
+
+	if p := strings.IndexByte(s, padding); p >= 0 {
+		// padding only in full codes, in pairs and followed by the separator only
+		end := strings.LastIndexByte(s, padding) + 1
+		if p == 0 || p%2 == 1 || position != separatorPosition || end != position ||
+			strings.Trim(s[p:end], string(padding)) != "" || (end-p)%2 == 1 {
+			return false
+		}
+	}
+
+	for _, r := range strings.Replace(strings.Replace(s, string(separator), "", 1), string(padding), "", -1) {
+		if !strings.ContainsRune(alphabet, r) {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+IsShort reports whether the code is a valid short code, which needs a reference location to be decoded.
+*/
+func (code Code) IsShort() bool {
+	return code.IsValid() && strings.IndexByte(string(code), separator) < separatorPosition
+}
+
+/*
+IsFull reports whether the code is a valid full code.
+*/
+func (code Code) IsFull() bool {
+
+	if !code.IsValid() || code.IsShort() {
+		return false
+	}
+	s := strings.ToUpper(string(code))
+	// the first digits must be within the range of latitude and longitude
+	return strings.IndexByte(alphabet, s[0])*20 < 180 && (len(s) < 2 || s[1] == padding || strings.IndexByte(alphabet, s[1])*20 < 360)
+}
+
+/*
+Decode returns the area of a full code.
+
+	For the city of Skagen: "9F9GPHCJ+22" -> 57.720000 10.580000 - 57.720125 10.580125
+*/
+func (code Code) Decode() (Area, error) {
+
+	if !code.IsFull() {
+		return Area{}, fmt.Errorf("not a full plus code, code = %s", code)
+	}
+	s := strings.NewReplacer(string(separator), "", string(padding), "").Replace(strings.ToUpper(string(code)))
+
+	latVal := int64(-90 * pairPrecision)
+	lonVal := int64(-180 * pairPrecision)
+	placeValue := int64(20 * 20 * 20 * 20)
+	digits := min(len(s), pairCodeLength)
+	for i := 0; i < digits; i += 2 {
+		latVal += int64(strings.IndexByte(alphabet, s[i])) * placeValue
+		lonVal += int64(strings.IndexByte(alphabet, s[i+1])) * placeValue
+		if i < digits-2 {
+			placeValue /= 20
+		}
+	}
+	latSize := float64(placeValue) / pairPrecision
+	lonSize := float64(placeValue) / pairPrecision
+
+	var extraLat, extraLon int64
+	if len(s) > pairCodeLength {
+		rowValue := int64(gridRows * gridRows * gridRows * gridRows)
+		columnValue := int64(gridColumns * gridColumns * gridColumns * gridColumns)
+		digits = min(len(s), maxCodeLength)
+		for i := pairCodeLength; i < digits; i++ {
+			digit := int64(strings.IndexByte(alphabet, s[i]))
+			extraLat += digit / gridColumns * rowValue
+			extraLon += digit % gridColumns * columnValue
+			if i < digits-1 {
+				rowValue /= gridRows
+				columnValue /= gridColumns
+			}
+		}
+		latSize = float64(rowValue) / finalLatPrecision
+		lonSize = float64(columnValue) / finalLonPrecision
+	}
+
+	south := float64(latVal)/pairPrecision + float64(extraLat)/finalLatPrecision
+	west := float64(lonVal)/pairPrecision + float64(extraLon)/finalLonPrecision
+	return Area{
+		SouthWest: proj.LL{Lat: south, Lon: west},
+		NorthEast: proj.LL{Lat: south + latSize, Lon: west + lonSize},
+		Length:    min(len(s), maxCodeLength),
+	}, nil
+}
+
+// String returns the stringified code
+func (code Code) String() string {
+	return string(code)
+}
+
+// System returns the name of the coordinate system
+func (code Code) System() string {
+	return System
+}
+
+// ToLL converts a full code to the centre of its area
+func (code Code) ToLL() (proj.LL, error) {
+	area, err := code.Decode()
+	if err != nil {
+		return proj.LL{}, err
+	}
+	return area.Center(), nil
+}
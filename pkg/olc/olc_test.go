@@ -0,0 +1,126 @@
+package olc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestEncode(t *testing.T) {
+
+	var tests = []struct {
+		ll     proj.LL // in
+		length int     // in
+		code   Code    // out
+		err    error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 20.375, Lon: 2.775}, 6, "7FG49Q00+", nil},
+		{proj.LL{Lat: 20.3700625, Lon: 2.7821875}, 10, "7FG49QCJ+2V", nil},
+		{proj.LL{Lat: 20.3701125, Lon: 2.782234375}, 11, "7FG49QCJ+2VX", nil},
+		{proj.LL{Lat: 20.3701135, Lon: 2.78223535156}, 13, "7FG49QCJ+2VXGJ", nil},
+		{proj.LL{Lat: 47.0000625, Lon: 8.0000625}, 10, "8FVC2222+22", nil},
+		{proj.LL{Lat: -41.2730625, Lon: 174.7859375}, 10, "4VCPPQGP+Q9", nil},
+		{proj.LL{Lat: 0.5, Lon: -179.5}, 4, "62G20000+", nil},
+		{proj.LL{Lat: -89.5, Lon: -179.5}, 4, "22220000+", nil},
+		{proj.LL{Lat: 90, Lon: 1}, 4, "CFX30000+", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 10, "9F9GPHCJ+22", nil},
+		// negative tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 7, "", fmt.Errorf("invalid code length, length = 7")},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 16, "", fmt.Errorf("invalid code length, length = 16")},
+	}
+
+	for _, test := range tests {
+		code, err := Encode(test.ll, test.length)
+		function := fmt.Sprintf("Encode(%s, %d)", test.ll, test.length)
+		got := fmt.Sprintf("%s %v", code, err)
+		want := fmt.Sprintf("%s %v", test.code, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestCode_Decode(t *testing.T) {
+
+	var tests = []struct {
+		code Code  // in
+		area Area  // out
+		err  error // out
+	}{
+		// positive tests
+		{"7FG49Q00+", Area{proj.LL{Lat: 20.35, Lon: 2.75}, proj.LL{Lat: 20.4, Lon: 2.8}, 6}, nil},
+		{"9F9GPHCJ+22", Area{proj.LL{Lat: 57.72, Lon: 10.58}, proj.LL{Lat: 57.720125, Lon: 10.580125}, 10}, nil},
+		{"9f9gphcj+22", Area{proj.LL{Lat: 57.72, Lon: 10.58}, proj.LL{Lat: 57.720125, Lon: 10.580125}, 10}, nil},
+		{"7FG49QCJ+2VXGJ", Area{proj.LL{Lat: 20.370113, Lon: 2.782234}, proj.LL{Lat: 20.370114, Lon: 2.782236}, 13}, nil},
+		// negative tests
+		{"PHCJ+22", Area{}, fmt.Errorf("not a full plus code, code = PHCJ+22")},
+		{"9F9GPHCJ+2", Area{}, fmt.Errorf("not a full plus code, code = 9F9GPHCJ+2")},
+		{"9F9GPHCJ22", Area{}, fmt.Errorf("not a full plus code, code = 9F9GPHCJ22")},
+		{"9F9GPHCA+22", Area{}, fmt.Errorf("not a full plus code, code = 9F9GPHCA+22")},
+		{"WF9GPHCJ+22", Area{}, fmt.Errorf("not a full plus code, code = WF9GPHCJ+22")},
+	}
+
+	for _, test := range tests {
+		area, err := test.code.Decode()
+		function := fmt.Sprintf("code = %s, Decode()", test.code)
+		got := fmt.Sprintf("%v %v", area, err)
+		want := fmt.Sprintf("%v %v", test.area, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestCode_IsValid(t *testing.T) {
+
+	var tests = []struct {
+		code  Code // in
+		valid bool // out
+		short bool // out
+		full  bool // out
+	}{
+		{"8FVC2222+22", true, false, true},
+		{"8FVC2222+", true, false, true},
+		{"8FVC0000+", true, false, true},
+		{"8fvc2222+22", true, false, true},
+		{"8FVC2222+22X", true, false, true},
+		{"2222+22", true, true, false},
+		{"+22", true, true, false},
+		{"8FVC00+", false, false, false},
+		{"8FVC0200+", false, false, false},
+		{"8FVC2222+2", false, false, false},
+		{"8F+", false, false, false},
+		{"8FVC2222", false, false, false},
+		{"8FVC+2222+22", false, false, false},
+	}
+
+	for _, test := range tests {
+		function := fmt.Sprintf("code = %s, IsValid() IsShort() IsFull()", test.code)
+		got := fmt.Sprintf("%t %t %t", test.code.IsValid(), test.code.IsShort(), test.code.IsFull())
+		want := fmt.Sprintf("%t %t %t", test.valid, test.short, test.full)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+
+	code, err := proj.Convert[Code](proj.MGRS("32VNJ9485799059"))
+	function := "proj.Convert[Code](32VNJ9485799059)"
+	got := fmt.Sprintf("%s %v", code, err)
+	want := "9F9GPHFV+F29 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+
+	parsed, err := proj.Parse("9F9GPHCJ+22")
+	function = "proj.Parse(9F9GPHCJ+22)"
+	got = fmt.Sprintf("%s %s %v", parsed.Coordinate, parsed.System, err)
+	want = "9F9GPHCJ+22 PLUSCODE <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+}
@@ -0,0 +1,153 @@
+package olc
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// pairResolutions holds the size in degrees of the area of each pair of digits
+var pairResolutions = [5]float64{20.0, 1.0, 0.05, 0.0025, 0.000125}
+
+/*
+Shorten removes 2, 4, 6 or 8 digits from a full code relative to a reference location.
+
+The more digits are removed the nearer the reference location must be.
+The full code is returned when the reference location is too far away to shorten the code.
+
+	For the city of Skagen: "9F9GPHCJ+22" relative to Frederikshavn 57.44 10.54 -> "PHCJ+22"
+*/
+func (code Code) Shorten(reference proj.LL) (Code, error) {
+
+	if !code.IsFull() {
+		return "", fmt.Errorf("not a full plus code, code = %s", code)
+	}
+	if strings.IndexByte(string(code), padding) >= 0 {
+		return "", fmt.Errorf("padded plus code can not be shortened, code = %s", code)
+	}
+	area, err := code.Decode()
+	if err != nil {
+		return "", err
+	}
+	if area.Length < 6 {
+		return "", fmt.Errorf("plus code too short to be shortened, code = %s", code)
+	}
+
+	center := area.Center()
+	distance := math.Max(math.Abs(center.Lat-clipLatitude(reference.Lat)), math.Abs(normalizeLongitude(center.Lon-reference.Lon)))
+	s := strings.ToUpper(string(code))
+	for i := len(pairResolutions) - 2; i >= 1; i-- {
+		// 0.3 instead of 0.5 of the resolution gives a margin of safety
+		if distance < pairResolutions[i]*0.3 {
+			return Code(s[(i+1)*2:]), nil
+		}
+	}
+	return Code(s), nil
+}
+
+/*
+RecoverNearest recovers the full code of a short code nearest to the reference location.
+
+A full code is returned unchanged.
+
+	For the city of Skagen: "PHCJ+22" relative to Frederikshavn 57.44 10.54 -> "9F9GPHCJ+22"
+*/
+func (code Code) RecoverNearest(reference proj.LL) (Code, error) {
+
+	if code.IsFull() {
+		return Code(strings.ToUpper(string(code))), nil
+	}
+	if !code.IsShort() {
+		return "", fmt.Errorf("invalid plus code, code = %s", code)
+	}
+
+	lat := clipLatitude(reference.Lat)
+	lon := normalizeLongitude(reference.Lon)
+	s := strings.ToUpper(string(code))
+
+	// the digits missing from the short code are taken from the reference location
+	missing := separatorPosition - strings.IndexByte(s, separator)
+	resolution := math.Pow(20, float64(2-missing/2))
+	half := resolution / 2
+	prefix, err := Encode(proj.LL{Lat: lat, Lon: lon}, maxCodeLength)
+	if err != nil {
+		return "", err
+	}
+	area, err := Code(string(prefix[:missing]) + s).Decode()
+	if err != nil {
+		return "", err
+	}
+
+	// the nearest area may be in the neighbouring cell of the reference location
+	center := area.Center()
+	if lat+half < center.Lat && center.Lat-resolution >= -90 {
+		center.Lat -= resolution
+	} else if lat-half > center.Lat && center.Lat+resolution <= 90 {
+		center.Lat += resolution
+	}
+	if lon+half < center.Lon {
+		center.Lon -= resolution
+	} else if lon-half > center.Lon {
+		center.Lon += resolution
+	}
+	return Encode(center, area.Length)
+}
+
+/*
+ShortenNearestCity shortens a full code relative to the nearest city.
+
+The short code is written followed by the name of the city, e.g. "PHCJ+22 Skagen".
+The full code is returned when no city is near enough to shorten the code.
+*/
+func (code Code) ShortenNearestCity(cities []proj.City) (Code, proj.City, error) {
+
+	center, err := code.ToLL()
+	if err != nil {
+		return "", proj.City{}, err
+	}
+	city, err := proj.NearestCity(center, cities)
+	if err != nil {
+		return "", proj.City{}, err
+	}
+	short, err := code.Shorten(city.Geoloc)
+	return short, city, err
+}
+
+/*
+RecoverLocality recovers a short code followed by the name of a city, e.g. "PHCJ+22 Skagen".
+
+The city is looked up in cities by name ignoring case.
+*/
+func RecoverLocality(text string, cities []proj.City) (Code, error) {
+
+	short, name, found := strings.Cut(strings.TrimSpace(text), " ")
+	short = strings.TrimRight(short, ",")
+	name = strings.TrimSpace(strings.TrimLeft(name, ", "))
+	if !found || name == "" {
+		return "", fmt.Errorf("missing city name, text = %s", text)
+	}
+	for _, city := range cities {
+		if strings.EqualFold(city.Name, name) {
+			return Code(short).RecoverNearest(city.Geoloc)
+		}
+	}
+	return "", fmt.Errorf("unknown city, name = %s", name)
+}
+
+// clipLatitude limits the latitude to the range -90 to 90
+func clipLatitude(lat float64) float64 {
+	return min(max(lat, -90), 90)
+}
+
+// normalizeLongitude wraps the longitude into the range -180 to 180
+func normalizeLongitude(lon float64) float64 {
+	for lon < -180 {
+		lon += 360
+	}
+	for lon >= 180 {
+		lon -= 360
+	}
+	return lon
+}
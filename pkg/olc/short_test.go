@@ -0,0 +1,109 @@
+package olc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// cities holds a few cities as reference locations
+var cities = []proj.City{
+	{Name: "Skagen", Geoloc: proj.LL{Lat: 57.72, Lon: 10.58}},
+	{Name: "Frederikshavn", Geoloc: proj.LL{Lat: 57.44, Lon: 10.54}},
+	{Name: "København", Geoloc: proj.LL{Lat: 55.68, Lon: 12.57}},
+}
+
+func TestCode_Shorten(t *testing.T) {
+
+	var tests = []struct {
+		code      Code    // in
+		reference proj.LL // in
+		short     Code    // out
+		err       error   // out
+	}{
+		// positive tests
+		{"9C3W9QCJ+2VX", proj.LL{Lat: 51.3701125, Lon: -1.217765625}, "+2VX", nil},
+		{"9C3W9QCJ+2VX", proj.LL{Lat: 51.3708675, Lon: -1.217765625}, "CJ+2VX", nil},
+		{"9F9GPHCJ+22", proj.LL{Lat: 57.44, Lon: 10.54}, "PHCJ+22", nil},
+		{"9F9GPHCJ+22", proj.LL{Lat: 55.68, Lon: 12.57}, "9F9GPHCJ+22", nil},
+		// negative tests
+		{"PHCJ+22", proj.LL{Lat: 57.44, Lon: 10.54}, "", fmt.Errorf("not a full plus code, code = PHCJ+22")},
+		{"9F9G0000+", proj.LL{Lat: 57.44, Lon: 10.54}, "", fmt.Errorf("padded plus code can not be shortened, code = 9F9G0000+")},
+	}
+
+	for _, test := range tests {
+		short, err := test.code.Shorten(test.reference)
+		function := fmt.Sprintf("code = %s, Shorten(%s)", test.code, test.reference)
+		got := fmt.Sprintf("%s %v", short, err)
+		want := fmt.Sprintf("%s %v", test.short, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestCode_RecoverNearest(t *testing.T) {
+
+	var tests = []struct {
+		code      Code    // in
+		reference proj.LL // in
+		full      Code    // out
+		err       error   // out
+	}{
+		// positive tests
+		{"CJ+2VX", proj.LL{Lat: 51.3708675, Lon: -1.217765625}, "9C3W9QCJ+2VX", nil},
+		{"PHCJ+22", proj.LL{Lat: 57.44, Lon: 10.54}, "9F9GPHCJ+22", nil},
+		{"phcj+22", proj.LL{Lat: 57.72, Lon: 10.58}, "9F9GPHCJ+22", nil},
+		{"9F9GPHCJ+22", proj.LL{Lat: 55.68, Lon: 12.57}, "9F9GPHCJ+22", nil},
+		// negative tests
+		{"PHCJ+2", proj.LL{Lat: 57.44, Lon: 10.54}, "", fmt.Errorf("invalid plus code, code = PHCJ+2")},
+	}
+
+	for _, test := range tests {
+		full, err := test.code.RecoverNearest(test.reference)
+		function := fmt.Sprintf("code = %s, RecoverNearest(%s)", test.code, test.reference)
+		got := fmt.Sprintf("%s %v", full, err)
+		want := fmt.Sprintf("%s %v", test.full, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestCode_ShortenNearestCity(t *testing.T) {
+
+	short, city, err := Code("9F9GPJJ2+22").ShortenNearestCity(cities)
+	function := "code = 9F9GPJJ2+22, ShortenNearestCity()"
+	got := fmt.Sprintf("%s %s %v", short, city.Name, err)
+	want := "PJJ2+22 Skagen <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+}
+
+func TestRecoverLocality(t *testing.T) {
+
+	var tests = []struct {
+		text string // in
+		full Code   // out
+		err  error  // out
+	}{
+		// positive tests
+		{"PHCJ+22 Frederikshavn", "9F9GPHCJ+22", nil},
+		{"PJJ2+22, skagen", "9F9GPJJ2+22", nil},
+		// negative tests
+		{"PHCJ+22", "", fmt.Errorf("missing city name, text = PHCJ+22")},
+		{"PHCJ+22 Aalborg", "", fmt.Errorf("unknown city, name = Aalborg")},
+	}
+
+	for _, test := range tests {
+		full, err := RecoverLocality(test.text, cities)
+		function := fmt.Sprintf("RecoverLocality(%q)", test.text)
+		got := fmt.Sprintf("%s %v", full, err)
+		want := fmt.Sprintf("%s %v", test.full, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package osgrid
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// BNG defines a coordinate of the British National Grid in meters
+/*
+The British National Grid (EPSG:27700) is transverse Mercator on Airy 1830 of the datum OSGB36
+with true origin 49°N 2°W, scale factor 0.9996012717 and false origin 400000 west and 100000 north of the true origin.
+
+	For the Caister water tower: E 651409.90 N 313177.27 or "TG 51409 13177"
+*/
+type BNG struct {
+	Easting  float64
+	Northing float64
+}
+
+// SystemBNG is the name of the British National Grid coordinate system
+const SystemBNG = "BNG"
+
+// bng is the projection of the British National Grid
+var bng = proj.TransverseMercator{
+	Ellipsoid:     proj.Airy1830,
+	Lat0:          49,
+	Lon0:          -2,
+	K0:            0.9996012717,
+	FalseEasting:  400000,
+	FalseNorthing: -100000,
+}
+
+// wgs84ToOSGB36 is the datum shift from WGS84 to OSGB36 published by Ordnance Survey
+var wgs84ToOSGB36 = proj.Helmert{Tx: -446.448, Ty: 125.157, Tz: -542.060, S: 20.4894, Rx: -0.1502, Ry: -0.2470, Rz: -0.8421}
+
+func init() {
+	proj.Register(SystemBNG, func(ll proj.LL) (proj.Coordinate, error) { return BNGFromLL(ll) })
+	proj.RegisterParser(SystemBNG, func(text string) (proj.Coordinate, float64, error) {
+		b, err := ParseBNG(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		return b, 1.0, nil
+	})
+}
+
+/*
+BNGFromLL converts WGS84 latitude longitude to the British National Grid.
+
+The datum shift is the Helmert transformation of Ordnance Survey, which is accurate to a few meters.
+
+	For the Caister water tower: BNGFromLL(proj.LL{Lat: 52.658008, Lon: 1.716074}) -> "TG 51411 13180"
+*/
+func BNGFromLL(ll proj.LL) (BNG, error) {
+
+	osgb36, err := wgs84ToOSGB36.Transform(ll, proj.WGS84, proj.Airy1830)
+	if err != nil {
+		return BNG{}, err
+	}
+	return BNGFromOSGB36(osgb36)
+}
+
+/*
+BNGFromOSGB36 projects OSGB36 latitude longitude to the British National Grid.
+
+	For the Caister water tower: BNGFromOSGB36(proj.LL{Lat: 52.657570, Lon: 1.717922}) -> E 651409.90 N 313177.27
+*/
+func BNGFromOSGB36(ll proj.LL) (BNG, error) {
+
+	easting, northing, err := bng.Forward(ll)
+	if err != nil {
+		return BNG{}, err
+	}
+	return BNG{Easting: easting, Northing: northing}, nil
+}
+
+/*
+ParseBNG parses a grid reference with two letters and 0 to 10 digits.
+
+The coordinate is the south-west corner of the square of the reference.
+
+	For the Caister water tower: "TG 51409 13177", "TG5140913177" or "TG 514 131"
+*/
+func ParseBNG(s string) (BNG, error) {
+
+	letters, easting, northing, err := parseReference(s)
+	if err != nil || len(letters) != 2 {
+		return BNG{}, fmt.Errorf("bad conversion, bng = %s", s)
+	}
+	e100, n100, err := bngOrigin(letters)
+	if err != nil {
+		return BNG{}, err
+	}
+	return BNG{Easting: e100 + easting, Northing: n100 + northing}, nil
+}
+
+// bngOrigin returns the south-west corner of the 100 km square of the grid letters
+func bngOrigin(letters string) (easting, northing float64, err error) {
+
+	col500, row500, ok500 := squareIndex(letters[0])
+	col100, row100, ok100 := squareIndex(letters[1])
+	easting = float64((col500-2)*500000 + col100*100000)
+	northing = float64((row500-1)*500000 + row100*100000)
+	if !ok500 || !ok100 || easting < 0 || easting >= 700000 || northing < 0 || northing >= 1300000 {
+		return 0, 0, fmt.Errorf("invalid grid letters, letters = %s", letters)
+	}
+	return easting, northing, nil
+}
+
+/*
+Letters returns the two letters of the 100 km square.
+
+The first letter is the 500 km square with S at the false origin, the second letter the 100 km square within it.
+
+	For the Caister water tower: "TG"
+*/
+func (b BNG) Letters() (string, error) {
+
+	if b.Easting < 0 || b.Easting >= 700000 || b.Northing < 0 || b.Northing >= 1300000 {
+		return "", fmt.Errorf("outside the grid, bng = %.2f %.2f", b.Easting, b.Northing)
+	}
+	e100 := int(math.Floor(b.Easting / 100000))
+	n100 := int(math.Floor(b.Northing / 100000))
+	return string([]byte{square(e100/5+2, n100/5+1), square(e100%5, n100%5)}), nil
+}
+
+/*
+Reference returns the grid reference with digits 0 to 5 of both easting and northing.
+
+	For the Caister water tower: Reference(5) -> "TG 51409 13177", Reference(3) -> "TG 514 131", Reference(0) -> "TG"
+*/
+func (b BNG) Reference(digits int) (string, error) {
+
+	letters, err := b.Letters()
+	if err != nil {
+		return "", err
+	}
+	return reference(letters, b.Easting, b.Northing, digits)
+}
+
+// String returns the grid reference to a meter, e.g. "TG 51409 13177"
+func (b BNG) String() string {
+	ref, err := b.Reference(5)
+	if err != nil {
+		return err.Error()
+	}
+	return ref
+}
+
+// System returns the name of the coordinate system
+func (b BNG) System() string {
+	return SystemBNG
+}
+
+// EPSG returns the EPSG code of the British National Grid
+func (b BNG) EPSG() int {
+	return 27700
+}
+
+/*
+OSGB36 projects the British National Grid to OSGB36 latitude longitude.
+*/
+func (b BNG) OSGB36() (proj.LL, error) {
+	return bng.Inverse(b.Easting, b.Northing)
+}
+
+/*
+ToLL converts the British National Grid to WGS84 latitude longitude.
+*/
+func (b BNG) ToLL() (proj.LL, error) {
+
+	osgb36, err := b.OSGB36()
+	if err != nil {
+		return proj.LL{}, err
+	}
+	return wgs84ToOSGB36.Inverse().Transform(osgb36, proj.Airy1830, proj.WGS84)
+}
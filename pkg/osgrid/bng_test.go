@@ -0,0 +1,135 @@
+package osgrid
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// TestBNGFromOSGB36 validates against the worked example of the Ordnance Survey guide to coordinate systems
+func TestBNGFromOSGB36(t *testing.T) {
+
+	// Caister water tower 52°39'27.2531"N 1°43'4.5177"E
+	osgb36 := proj.LL{Lat: 52 + 39/60.0 + 27.2531/3600, Lon: 1 + 43/60.0 + 4.5177/3600}
+
+	b, err := BNGFromOSGB36(osgb36)
+	function := fmt.Sprintf("BNGFromOSGB36(%s)", osgb36)
+	got := fmt.Sprintf("%.3f %.3f %v", b.Easting, b.Northing, err)
+	want := "651409.903 313177.270 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+
+	ll, err := b.OSGB36()
+	function = fmt.Sprintf("%s.OSGB36()", b)
+	got = fmt.Sprintf("%.9f %.9f %v", ll.Lat, ll.Lon, err)
+	want = fmt.Sprintf("%.9f %.9f <nil>", osgb36.Lat, osgb36.Lon)
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+}
+
+func TestBNGFromLL(t *testing.T) {
+
+	var tests = []struct {
+		ll  proj.LL // in
+		bng string  // out
+		err error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 52.658008, Lon: 1.716074}, "TG 51411 13180", nil},
+		{proj.LL{Lat: 51.5007, Lon: -0.1246}, "TQ 30269 79640", nil},
+		// negative tests
+		{proj.LL{Lat: 52.658008, Lon: 190}, "SV 00000 00000", fmt.Errorf("invalid longitude, lon = 190")},
+	}
+
+	for _, test := range tests {
+		b, err := BNGFromLL(test.ll)
+		function := fmt.Sprintf("BNGFromLL(%s)", test.ll)
+		got := fmt.Sprintf("%s %v", b, err)
+		want := fmt.Sprintf("%s %v", test.bng, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		// the inverse datum shift is accurate to a millimeter
+		ll, err := b.ToLL()
+		function = fmt.Sprintf("%s.ToLL()", b)
+		got = fmt.Sprintf("%.6f %.6f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.6f %.6f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestBNG_Reference(t *testing.T) {
+
+	var tests = []struct {
+		bng       BNG    // in
+		digits    int    // in
+		reference string // out
+		err       error  // out
+	}{
+		// positive tests
+		{BNG{Easting: 651409.903, Northing: 313177.270}, 5, "TG 51409 13177", nil},
+		{BNG{Easting: 651409.903, Northing: 313177.270}, 3, "TG 514 131", nil},
+		{BNG{Easting: 651409.903, Northing: 313177.270}, 1, "TG 5 1", nil},
+		{BNG{Easting: 651409.903, Northing: 313177.270}, 0, "TG", nil},
+		{BNG{Easting: 0, Northing: 0}, 5, "SV 00000 00000", nil},
+		{BNG{Easting: 465000, Northing: 1205000}, 2, "HP 65 05", nil},
+		{BNG{Easting: 530080, Northing: 180991.9999999}, 5, "TQ 30080 80992", nil},
+		// negative tests
+		{BNG{Easting: -1, Northing: 0}, 5, "", fmt.Errorf("outside the grid, bng = -1.00 0.00")},
+		{BNG{Easting: 0, Northing: 1300000}, 5, "", fmt.Errorf("outside the grid, bng = 0.00 1300000.00")},
+		{BNG{Easting: 0, Northing: 0}, 6, "", fmt.Errorf("invalid precision, digits = 6")},
+	}
+
+	for _, test := range tests {
+		reference, err := test.bng.Reference(test.digits)
+		function := fmt.Sprintf("BNG{%.2f %.2f}.Reference(%d)", test.bng.Easting, test.bng.Northing, test.digits)
+		got := fmt.Sprintf("%s %v", reference, err)
+		want := fmt.Sprintf("%s %v", test.reference, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParseBNG(t *testing.T) {
+
+	var tests = []struct {
+		s        string  // in
+		easting  float64 // out
+		northing float64 // out
+		err      error   // out
+	}{
+		// positive tests
+		{"TQ 30080 80992", 530080, 180992, nil},
+		{"tq3008080992", 530080, 180992, nil},
+		{"TG 514 131", 651400, 313100, nil},
+		{"TG", 600000, 300000, nil},
+		{"SV 00000 00000", 0, 0, nil},
+		{"HP 65 05", 465000, 1205000, nil},
+		// negative tests
+		{"TG 514 13", 0, 0, fmt.Errorf("bad conversion, bng = TG 514 13")},
+		{"TI 514 131", 0, 0, fmt.Errorf("bad conversion, bng = TI 514 131")},
+		{"O 15900 34671", 0, 0, fmt.Errorf("bad conversion, bng = O 15900 34671")},
+		{"AA 514 131", 0, 0, fmt.Errorf("invalid grid letters, letters = AA")},
+		{"TZ 514 131", 0, 0, fmt.Errorf("invalid grid letters, letters = TZ")},
+	}
+
+	for _, test := range tests {
+		b, err := ParseBNG(test.s)
+		function := fmt.Sprintf("ParseBNG(%s)", test.s)
+		got := fmt.Sprintf("%.2f %.2f %v", b.Easting, b.Northing, err)
+		want := fmt.Sprintf("%.2f %.2f %v", test.easting, test.northing, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+// Package osgrid converts between the British National Grid, the Irish Grid, Irish Transverse Mercator and latitude longitude
+/*
+The grids are transverse Mercator projections, see [proj.TransverseMercator]
+
+  - British National Grid (EPSG:27700) on OSGB36 with references of two letters, e.g. "TQ 30080 80992"
+  - Irish Grid (EPSG:29903) on Ireland 1965 with references of one letter, e.g. "O 15900 34671"
+  - Irish Transverse Mercator (EPSG:2157) on ETRS89, written with easting and northing, e.g. "ITM 715826.51 734697.59"
+
+The letters of a reference denote the 100 km square like the 100 km square identification of MGRS,
+the digits are easting and northing within the square, truncated to the precision of the reference.
+
+The British National Grid and the Irish Grid use the seven parameter Helmert transformations from WGS84
+published by the national mapping agencies, see [proj.Helmert]. The transformations are accurate to a few meters,
+the official transformations OSTN15 and OSi/OSNI polynomials are not part of the package.
+
+	bng, err := osgrid.BNGFromLL(proj.LL{Lat: 51.5007, Lon: -0.1246})
+	ref, err := bng.Reference(3)
+
+The package registers the coordinate systems BNG, IRISHGRID and ITM with package proj, see [proj.Convert] and [proj.Parse].
+
+Links:
+  - https://www.ordnancesurvey.co.uk/documents/resources/guide-coordinate-systems-great-britain.pdf
+  - https://epsg.io/27700
+  - https://epsg.io/29903
+  - https://epsg.io/2157
+*/
+package osgrid
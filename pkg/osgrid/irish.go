@@ -0,0 +1,142 @@
+package osgrid
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// IrishGrid defines a coordinate of the Irish Grid in meters
+/*
+The Irish Grid (EPSG:29903) is transverse Mercator on Airy Modified 1849 of the datum Ireland 1965
+with true origin 53.5°N 8°W, scale factor 1.000035 and false origin 200000 west and 250000 south of the true origin.
+
+	For Dublin: E 315900.55 N 234671.41 or "O 15900 34671"
+*/
+type IrishGrid struct {
+	Easting  float64
+	Northing float64
+}
+
+// SystemIrishGrid is the name of the Irish Grid coordinate system
+const SystemIrishGrid = "IRISHGRID"
+
+// irishGrid is the projection of the Irish Grid
+var irishGrid = proj.TransverseMercator{
+	Ellipsoid:     proj.AiryModified1849,
+	Lat0:          53.5,
+	Lon0:          -8,
+	K0:            1.000035,
+	FalseEasting:  200000,
+	FalseNorthing: 250000,
+}
+
+// wgs84ToIreland1965 is the datum shift from WGS84 to Ireland 1965 published by Ordnance Survey Ireland
+var wgs84ToIreland1965 = proj.Helmert{Tx: -482.530, Ty: 130.596, Tz: -564.557, S: -8.150, Rx: 1.042, Ry: 0.214, Rz: 0.631}
+
+func init() {
+	proj.Register(SystemIrishGrid, func(ll proj.LL) (proj.Coordinate, error) { return IrishGridFromLL(ll) })
+	proj.RegisterParser(SystemIrishGrid, func(text string) (proj.Coordinate, float64, error) {
+		ig, err := ParseIrishGrid(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		return ig, 1.0, nil
+	})
+}
+
+/*
+IrishGridFromLL converts WGS84 latitude longitude to the Irish Grid.
+
+The datum shift is the Helmert transformation of Ordnance Survey Ireland, which is accurate to a few meters.
+
+	For Dublin: IrishGridFromLL(proj.LL{Lat: 53.3498, Lon: -6.2603}) -> "O 15900 34671"
+*/
+func IrishGridFromLL(ll proj.LL) (IrishGrid, error) {
+
+	ireland1965, err := wgs84ToIreland1965.Transform(ll, proj.WGS84, proj.AiryModified1849)
+	if err != nil {
+		return IrishGrid{}, err
+	}
+	easting, northing, err := irishGrid.Forward(ireland1965)
+	if err != nil {
+		return IrishGrid{}, err
+	}
+	return IrishGrid{Easting: easting, Northing: northing}, nil
+}
+
+/*
+ParseIrishGrid parses a grid reference with one letter and 0 to 10 digits.
+
+The coordinate is the south-west corner of the square of the reference.
+
+	For Dublin: "O 15900 34671", "O1590034671" or "O 159 346"
+*/
+func ParseIrishGrid(s string) (IrishGrid, error) {
+
+	letters, easting, northing, err := parseReference(s)
+	if err != nil || len(letters) != 1 {
+		return IrishGrid{}, fmt.Errorf("bad conversion, irish grid = %s", s)
+	}
+	col, row, _ := squareIndex(letters[0])
+	return IrishGrid{Easting: float64(col)*100000 + easting, Northing: float64(row)*100000 + northing}, nil
+}
+
+/*
+Letter returns the letter of the 100 km square.
+
+	For Dublin: "O"
+*/
+func (ig IrishGrid) Letter() (string, error) {
+
+	if ig.Easting < 0 || ig.Easting >= 500000 || ig.Northing < 0 || ig.Northing >= 500000 {
+		return "", fmt.Errorf("outside the grid, irish grid = %.2f %.2f", ig.Easting, ig.Northing)
+	}
+	return string(square(int(math.Floor(ig.Easting/100000)), int(math.Floor(ig.Northing/100000)))), nil
+}
+
+/*
+Reference returns the grid reference with digits 0 to 5 of both easting and northing.
+
+	For Dublin: Reference(5) -> "O 15900 34671", Reference(3) -> "O 159 346"
+*/
+func (ig IrishGrid) Reference(digits int) (string, error) {
+
+	letter, err := ig.Letter()
+	if err != nil {
+		return "", err
+	}
+	return reference(letter, ig.Easting, ig.Northing, digits)
+}
+
+// String returns the grid reference to a meter, e.g. "O 15900 34671"
+func (ig IrishGrid) String() string {
+	ref, err := ig.Reference(5)
+	if err != nil {
+		return err.Error()
+	}
+	return ref
+}
+
+// System returns the name of the coordinate system
+func (ig IrishGrid) System() string {
+	return SystemIrishGrid
+}
+
+// EPSG returns the EPSG code of the Irish Grid
+func (ig IrishGrid) EPSG() int {
+	return 29903
+}
+
+/*
+ToLL converts the Irish Grid to WGS84 latitude longitude.
+*/
+func (ig IrishGrid) ToLL() (proj.LL, error) {
+
+	ireland1965, err := irishGrid.Inverse(ig.Easting, ig.Northing)
+	if err != nil {
+		return proj.LL{}, err
+	}
+	return wgs84ToIreland1965.Inverse().Transform(ireland1965, proj.AiryModified1849, proj.WGS84)
+}
@@ -0,0 +1,115 @@
+package osgrid
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestIrishGridFromLL(t *testing.T) {
+
+	var tests = []struct {
+		ll        proj.LL // in
+		irishGrid string  // out
+		err       error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 53.3498, Lon: -6.2603}, "O 15900 34671", nil},
+		// negative tests
+		{proj.LL{Lat: 53.3498, Lon: 190}, "V 00000 00000", fmt.Errorf("invalid longitude, lon = 190")},
+	}
+
+	for _, test := range tests {
+		ig, err := IrishGridFromLL(test.ll)
+		function := fmt.Sprintf("IrishGridFromLL(%s)", test.ll)
+		got := fmt.Sprintf("%s %v", ig, err)
+		want := fmt.Sprintf("%s %v", test.irishGrid, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		// the inverse datum shift is accurate to a millimeter
+		ll, err := ig.ToLL()
+		function = fmt.Sprintf("%s.ToLL()", ig)
+		got = fmt.Sprintf("%.6f %.6f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.6f %.6f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestIrishGrid_Origin validates the true origin of the projection
+func TestIrishGrid_Origin(t *testing.T) {
+
+	easting, northing, err := irishGrid.Forward(proj.LL{Lat: 53.5, Lon: -8})
+	got := fmt.Sprintf("%.2f %.2f %v", easting, northing, err)
+	want := "200000.00 250000.00 <nil>"
+	if got != want {
+		t.Errorf("\nirishGrid.Forward(53.5 -8) -> %s != %s\n", got, want)
+	}
+}
+
+func TestIrishGrid_Reference(t *testing.T) {
+
+	var tests = []struct {
+		irishGrid IrishGrid // in
+		digits    int       // in
+		reference string    // out
+		err       error     // out
+	}{
+		// positive tests
+		{IrishGrid{Easting: 315900.55, Northing: 234671.41}, 5, "O 15900 34671", nil},
+		{IrishGrid{Easting: 315900.55, Northing: 234671.41}, 3, "O 159 346", nil},
+		{IrishGrid{Easting: 315900.55, Northing: 234671.41}, 0, "O", nil},
+		{IrishGrid{Easting: 0, Northing: 0}, 5, "V 00000 00000", nil},
+		{IrishGrid{Easting: 499999, Northing: 499999}, 5, "E 99999 99999", nil},
+		// negative tests
+		{IrishGrid{Easting: 500000, Northing: 0}, 5, "", fmt.Errorf("outside the grid, irish grid = 500000.00 0.00")},
+		{IrishGrid{Easting: 0, Northing: 0}, -1, "", fmt.Errorf("invalid precision, digits = -1")},
+	}
+
+	for _, test := range tests {
+		reference, err := test.irishGrid.Reference(test.digits)
+		function := fmt.Sprintf("IrishGrid{%.2f %.2f}.Reference(%d)", test.irishGrid.Easting, test.irishGrid.Northing, test.digits)
+		got := fmt.Sprintf("%s %v", reference, err)
+		want := fmt.Sprintf("%s %v", test.reference, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParseIrishGrid(t *testing.T) {
+
+	var tests = []struct {
+		s        string  // in
+		easting  float64 // out
+		northing float64 // out
+		err      error   // out
+	}{
+		// positive tests
+		{"O 15900 34671", 315900, 234671, nil},
+		{"o1590034671", 315900, 234671, nil},
+		{"O 159 346", 315900, 234600, nil},
+		{"V 0 0", 0, 0, nil},
+		// negative tests
+		{"O 159 34", 0, 0, fmt.Errorf("bad conversion, irish grid = O 159 34")},
+		{"I 159 346", 0, 0, fmt.Errorf("bad conversion, irish grid = I 159 346")},
+		{"TQ 300 809", 0, 0, fmt.Errorf("bad conversion, irish grid = TQ 300 809")},
+	}
+
+	for _, test := range tests {
+		ig, err := ParseIrishGrid(test.s)
+		function := fmt.Sprintf("ParseIrishGrid(%s)", test.s)
+		got := fmt.Sprintf("%.2f %.2f %v", ig.Easting, ig.Northing, err)
+		want := fmt.Sprintf("%.2f %.2f %v", test.easting, test.northing, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
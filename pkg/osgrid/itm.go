@@ -0,0 +1,101 @@
+package osgrid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// ITM defines a coordinate of Irish Transverse Mercator in meters
+/*
+Irish Transverse Mercator (EPSG:2157) is transverse Mercator on GRS80 of the datum IRENET95 (ETRS89)
+with true origin 53.5°N 8°W, scale factor 0.99982 and false origin 600000 west and 750000 south of the true origin.
+ITM needs no datum shift from WGS84 at the accuracy of the package, and it has no grid letters.
+
+	For Dublin: "ITM 715826.51 734697.59"
+*/
+type ITM struct {
+	Easting  float64
+	Northing float64
+}
+
+// SystemITM is the name of the Irish Transverse Mercator coordinate system
+const SystemITM = "ITM"
+
+// itm is the projection of Irish Transverse Mercator
+var itm = proj.TransverseMercator{
+	Ellipsoid:     proj.GRS80,
+	Lat0:          53.5,
+	Lon0:          -8,
+	K0:            0.99982,
+	FalseEasting:  600000,
+	FalseNorthing: 750000,
+}
+
+func init() {
+	proj.Register(SystemITM, func(ll proj.LL) (proj.Coordinate, error) { return ITMFromLL(ll) })
+	proj.RegisterParser(SystemITM, func(text string) (proj.Coordinate, float64, error) {
+		i, err := ParseITM(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		return i, 1.0, nil
+	})
+}
+
+/*
+ITMFromLL projects ETRS89 latitude longitude to Irish Transverse Mercator.
+
+	For Dublin: ITMFromLL(proj.LL{Lat: 53.3498, Lon: -6.2603}) -> "ITM 715826.51 734697.59"
+*/
+func ITMFromLL(ll proj.LL) (ITM, error) {
+
+	easting, northing, err := itm.Forward(ll)
+	if err != nil {
+		return ITM{}, err
+	}
+	return ITM{Easting: easting, Northing: northing}, nil
+}
+
+/*
+ParseITM parses ITM followed by easting and northing separated by space or comma.
+
+	For Dublin: "ITM 715826.51 734697.59" or "ITM 715826, 734697"
+*/
+func ParseITM(s string) (ITM, error) {
+
+	fields := strings.Fields(strings.ReplaceAll(strings.ToUpper(s), ",", " "))
+	if len(fields) != 3 || fields[0] != SystemITM {
+		return ITM{}, fmt.Errorf("bad conversion, itm = %s", s)
+	}
+	easting, errE := strconv.ParseFloat(fields[1], 64)
+	northing, errN := strconv.ParseFloat(fields[2], 64)
+	if errE != nil || errN != nil {
+		return ITM{}, fmt.Errorf("bad conversion, itm = %s", s)
+	}
+	return ITM{Easting: easting, Northing: northing}, nil
+}
+
+// String returns the easting and northing, e.g. "ITM 715826.51 734697.59"
+func (i ITM) String() string {
+	return fmt.Sprintf("ITM %.2f %.2f", i.Easting, i.Northing)
+}
+
+// System returns the name of the coordinate system
+func (i ITM) System() string {
+	return SystemITM
+}
+
+// EPSG returns the EPSG code of Irish Transverse Mercator
+func (i ITM) EPSG() int {
+	return 2157
+}
+
+/*
+ToLL converts Irish Transverse Mercator to ETRS89 latitude longitude.
+*/
+func (i ITM) ToLL() (proj.LL, error) {
+	return itm.Inverse(i.Easting, i.Northing)
+}
@@ -0,0 +1,70 @@
+package osgrid
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestITMFromLL(t *testing.T) {
+
+	var tests = []struct {
+		ll  proj.LL // in
+		itm string  // out
+		err error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 53.3498, Lon: -6.2603}, "ITM 715826.51 734697.59", nil},
+		{proj.LL{Lat: 53.5, Lon: -8}, "ITM 600000.00 750000.00", nil},
+		// negative tests
+		{proj.LL{Lat: 91, Lon: -8}, "ITM 0.00 0.00", fmt.Errorf("invalid latitude, lat = 91")},
+	}
+
+	for _, test := range tests {
+		i, err := ITMFromLL(test.ll)
+		function := fmt.Sprintf("ITMFromLL(%s)", test.ll)
+		got := fmt.Sprintf("%s %v", i, err)
+		want := fmt.Sprintf("%s %v", test.itm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		ll, err := i.ToLL()
+		function = fmt.Sprintf("%s.ToLL()", i)
+		got = fmt.Sprintf("%.9f %.9f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.9f %.9f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParseITM(t *testing.T) {
+
+	var tests = []struct {
+		s   string // in
+		itm string // out
+		err error  // out
+	}{
+		// positive tests
+		{"ITM 715826.51 734697.59", "ITM 715826.51 734697.59", nil},
+		{"itm 715826, 734697", "ITM 715826.00 734697.00", nil},
+		// negative tests
+		{"ITM 715826.51", "ITM 0.00 0.00", fmt.Errorf("bad conversion, itm = ITM 715826.51")},
+		{"UTM 715826.51 734697.59", "ITM 0.00 0.00", fmt.Errorf("bad conversion, itm = UTM 715826.51 734697.59")},
+	}
+
+	for _, test := range tests {
+		i, err := ParseITM(test.s)
+		function := fmt.Sprintf("ParseITM(%s)", test.s)
+		got := fmt.Sprintf("%s %v", i, err)
+		want := fmt.Sprintf("%s %v", test.itm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
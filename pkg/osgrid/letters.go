@@ -0,0 +1,78 @@
+package osgrid
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/grid"
+)
+
+// gridLetters are the letters of a block of 5 by 5 squares from A in the north-west to Z in the south-east, I is not used
+const gridLetters = "ABCDEFGHJKLMNOPQRSTUVWXYZ"
+
+// referencePattern matches the grid letters followed by an even number of digits, with or without spaces
+var referencePattern = regexp.MustCompile(`^([A-HJ-Z]{1,2})\s*(\d*)\s*(\d*)$`)
+
+// square returns the letter of the square in a block of 5 by 5 squares, the row is counted from the south
+func square(col, row int) byte {
+	return gridLetters[(4-row)*5+col]
+}
+
+// squareIndex returns column and row counted from the south of the letter in a block of 5 by 5 squares
+func squareIndex(letter byte) (col, row int, ok bool) {
+	i := strings.IndexByte(gridLetters, letter)
+	if i < 0 {
+		return 0, 0, false
+	}
+	return i % 5, 4 - i/5, true
+}
+
+/*
+reference returns the grid letters followed by digits of easting and northing within the 100 km square.
+
+The digits are truncated, as a reference denotes the south-west corner of the square it is written with.
+
+	reference("TQ", 530080.4, 180992.7, 3) -> "TQ 300 809"
+*/
+func reference(letters string, easting, northing float64, digits int) (string, error) {
+
+	if digits < 0 || digits > 5 {
+		return "", fmt.Errorf("invalid precision, digits = %d", digits)
+	}
+	if digits == 0 {
+		return letters, nil
+	}
+	unit := math.Pow10(5 - digits)
+	e := int(grid.FloorMeters(math.Mod(easting, 100000))/unit) % int(math.Pow10(digits))
+	n := int(grid.FloorMeters(math.Mod(northing, 100000))/unit) % int(math.Pow10(digits))
+	return fmt.Sprintf("%s %0*d %0*d", letters, digits, e, digits, n), nil
+}
+
+/*
+parseReference splits a reference into the grid letters and easting northing of the south-west corner within the 100 km square.
+
+	"TQ 300 809" -> "TQ", 30000, 80900
+	"TQ3008080992" -> "TQ", 30080, 80992
+*/
+func parseReference(s string) (letters string, easting, northing float64, err error) {
+
+	m := referencePattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(s)))
+	if m == nil {
+		return "", 0, 0, fmt.Errorf("bad conversion, reference = %s", s)
+	}
+	digits := m[2] + m[3]
+	if (m[3] != "" && len(m[2]) != len(m[3])) || len(digits)%2 != 0 || len(digits) > 10 {
+		return "", 0, 0, fmt.Errorf("bad conversion, reference = %s", s)
+	}
+	if digits == "" {
+		return m[1], 0, 0, nil
+	}
+	half := len(digits) / 2
+	e, _ := strconv.Atoi(digits[:half])
+	n, _ := strconv.Atoi(digits[half:])
+	unit := math.Pow10(5 - half)
+	return m[1], float64(e) * unit, float64(n) * unit, nil
+}
@@ -1,6 +1,8 @@
 package proj
 
 import (
+	"fmt"
+	"math"
 	"strconv"
 )
 
@@ -73,3 +75,47 @@ func (city *City) BuildCity(koord []string) {
 	city.East, _ = strconv.ParseInt(koord[_East], 10, 64)
 	city.North, _ = strconv.ParseInt(koord[_North], 10, 64)
 }
+
+/*
+KmKv returns the identifier of the 1 km cell of the Danish square grid (DKN) containing the city, see package dkn.
+
+	For the city of Skagen: "1km_6399_594"
+*/
+func (city City) KmKv() string {
+	return city.kmKv
+}
+
+/*
+GeoURI returns a shareable geo URI of the city.
+
+	For the city of Skagen: "geo:57.72,10.58"
+*/
+func (city City) GeoURI() GeoURI {
+	return GeoURI{LL: city.Geoloc}
+}
+
+/*
+NearestCity returns the city nearest to latitude longitude measured along the great circle.
+*/
+func NearestCity(ll LL, cities []City) (City, error) {
+
+	if len(cities) == 0 {
+		return City{}, fmt.Errorf("no cities")
+	}
+	nearest := cities[0]
+	distance := math.Inf(1)
+	for _, city := range cities {
+		if d := greatCircle(ll, city.Geoloc); d < distance {
+			nearest, distance = city, d
+		}
+	}
+	return nearest, nil
+}
+
+// greatCircle returns the central angle in radians between two points by the haversine formula
+func greatCircle(from, to LL) float64 {
+	dLat := degToRad(to.Lat - from.Lat)
+	dLon := degToRad(to.Lon - from.Lon)
+	a := math.Pow(math.Sin(dLat/2), 2) + math.Cos(degToRad(from.Lat))*math.Cos(degToRad(to.Lat))*math.Pow(math.Sin(dLon/2), 2)
+	return 2 * math.Asin(math.Sqrt(a))
+}
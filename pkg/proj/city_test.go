@@ -0,0 +1,52 @@
+package proj
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNearestCity(t *testing.T) {
+
+	cities := []City{
+		{Name: "Skagen", Geoloc: LL{Lat: 57.72, Lon: 10.58}},
+		{Name: "Frederikshavn", Geoloc: LL{Lat: 57.44, Lon: 10.54}},
+		{Name: "Rønne", Geoloc: LL{Lat: 55.1003, Lon: 14.7065}},
+	}
+
+	var tests = []struct {
+		ll     LL     // in
+		cities []City // in
+		name   string // out
+		err    error  // out
+	}{
+		// positive tests
+		{LL{Lat: 57.5, Lon: 10.5}, cities, "Frederikshavn", nil},
+		{LL{Lat: 57.7, Lon: 10.6}, cities, "Skagen", nil},
+		{LL{Lat: 55.68, Lon: 12.57}, cities, "Rønne", nil},
+		// negative tests
+		{LL{Lat: 55.68, Lon: 12.57}, nil, "", fmt.Errorf("no cities")},
+	}
+
+	for _, test := range tests {
+		city, err := NearestCity(test.ll, test.cities)
+		function := fmt.Sprintf("NearestCity(%s)", test.ll)
+		got := fmt.Sprintf("%s %v", city.Name, err)
+		want := fmt.Sprintf("%s %v", test.name, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestCity_KmKv(t *testing.T) {
+
+	record := []string{"1", "Skagen", "9990", "Frederikshavn", "Region Nordjylland", "8000", "57.72", "10.58", "32", "V", "1km_6399_594", "594", "6399", "594857.92", "6399059.92"}
+	var city City
+	city.BuildCity(record)
+
+	got := fmt.Sprintf("%s %d %d", city.KmKv(), city.East, city.North)
+	want := "1km_6399_594 594 6399"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "KmKv()", got, want)
+	}
+}
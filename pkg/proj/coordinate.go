@@ -0,0 +1,199 @@
+package proj
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Coordinate is implemented by every coordinate system that can be converted to latitude longitude
+/*
+A coordinate system registers how it is built from latitude longitude with Register.
+Convert then routes between any two registered systems through latitude longitude,
+or through direct converters registered with RegisterConverter, e.g. MGRS -> USNG without loss of digits.
+
+	For the city of Skagen:
+	- LL: 57.720000 10.580000
+	- UTM: 32V 594857.92 6399059.92
+	- MGRS: 32VNJ9485799059
+*/
+type Coordinate interface {
+	ToLL() (LL, error)
+	System() string
+	String() string
+}
+
+// names of the coordinate systems of package proj
+const (
+	SystemLL   = "LL"
+	SystemUTM  = "UTM"
+	SystemMGRS = "MGRS"
+	SystemUSNG = "USNG"
+)
+
+// FromLL builds the coordinate of a coordinate system from latitude longitude
+type FromLL func(ll LL) (Coordinate, error)
+
+// Converter converts a coordinate directly from one coordinate system to another
+type Converter func(c Coordinate) (Coordinate, error)
+
+// registry holds the converters between the registered coordinate systems, from -> to -> converter
+var registry = struct {
+	sync.RWMutex
+	converters map[string]map[string]Converter
+}{converters: map[string]map[string]Converter{}}
+
+func init() {
+	Register(SystemLL, func(ll LL) (Coordinate, error) { return ll, nil })
+	Register(SystemUTM, func(ll LL) (Coordinate, error) {
+		if _, err := ll.validateLL(); err != nil {
+			return UTM{}, err
+		}
+		return ll.ToUTM(), nil
+	})
+	Register(SystemMGRS, func(ll LL) (Coordinate, error) { return ll.ToMGRS(1) })
+	Register(SystemUSNG, func(ll LL) (Coordinate, error) { return ll.ToUSNG(1) })
+
+	RegisterConverter(SystemUTM, SystemMGRS, func(c Coordinate) (Coordinate, error) { return c.(UTM).ToMGRSChecked(1) })
+	RegisterConverter(SystemUTM, SystemUSNG, func(c Coordinate) (Coordinate, error) { return c.(UTM).ToUSNGChecked(1) })
+	RegisterConverter(SystemMGRS, SystemUTM, func(c Coordinate) (Coordinate, error) {
+		utm, _, err := c.(MGRS).ToUTM()
+		return utm, err
+	})
+	RegisterConverter(SystemMGRS, SystemUSNG, func(c Coordinate) (Coordinate, error) { return c.(MGRS).ToUSNG(), nil })
+	RegisterConverter(SystemUSNG, SystemMGRS, func(c Coordinate) (Coordinate, error) { return c.(USNG).ToMGRS(), nil })
+	RegisterConverter(SystemUSNG, SystemUTM, func(c Coordinate) (Coordinate, error) {
+		utm, _, err := c.(USNG).ToUTM()
+		return utm, err
+	})
+}
+
+/*
+Register registers a coordinate system.
+
+The system is converted to latitude longitude by its ToLL method and from latitude longitude by fromLL,
+which makes it convertible to and from all other registered systems.
+Packages with new coordinate systems register them in their init function.
+*/
+func Register(system string, fromLL FromLL) {
+	RegisterConverter(system, SystemLL, func(c Coordinate) (Coordinate, error) { return c.ToLL() })
+	RegisterConverter(SystemLL, system, func(c Coordinate) (Coordinate, error) { return fromLL(c.(LL)) })
+}
+
+/*
+RegisterConverter registers a direct conversion between two coordinate systems.
+
+Direct conversions are preferred to conversions through latitude longitude, as they avoid loss of precision.
+Both systems become known, a system only registered as the target of converters can be converted to but not from.
+*/
+func RegisterConverter(from, to string, converter Converter) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	for _, system := range []string{from, to} {
+		if registry.converters[system] == nil {
+			registry.converters[system] = map[string]Converter{}
+		}
+	}
+	registry.converters[from][to] = converter
+}
+
+/*
+Systems returns the names of the registered coordinate systems in sorted order.
+*/
+func Systems() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	systems := make([]string, 0, len(registry.converters))
+	for system := range registry.converters {
+		systems = append(systems, system)
+	}
+	sort.Strings(systems)
+	return systems
+}
+
+/*
+Convert converts the coordinate to the coordinate system of T.
+
+The conversion uses the shortest route of registered converters.
+
+	For the city of Skagen: Convert[MGRS](UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}) -> 32VNJ9485799059
+*/
+func Convert[T Coordinate](c Coordinate) (T, error) {
+
+	var target T
+	result, err := ConvertTo(c, target.System())
+	if err != nil {
+		return target, err
+	}
+	converted, ok := result.(T)
+	if !ok {
+		return target, fmt.Errorf("invalid result of conversion to %s, result = %v", target.System(), result)
+	}
+	return converted, nil
+}
+
+/*
+ConvertTo converts the coordinate to the named coordinate system.
+*/
+func ConvertTo(c Coordinate, system string) (Coordinate, error) {
+
+	path, err := route(c.System(), system)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, converter := range path {
+		from := c.System()
+		c, err = converter(c)
+		if err != nil {
+			return nil, fmt.Errorf("error <%v> at conversion from %s", err, from)
+		}
+	}
+	return c, nil
+}
+
+/*
+route finds the shortest route of converters from one system to another by a breadth first search.
+*/
+func route(from, to string) ([]Converter, error) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	if _, ok := registry.converters[from]; !ok {
+		return nil, fmt.Errorf("unknown coordinate system, system = %s", from)
+	}
+	if _, ok := registry.converters[to]; !ok {
+		return nil, fmt.Errorf("unknown coordinate system, system = %s", to)
+	}
+
+	previous := map[string]string{from: ""}
+	queue := []string{from}
+	for len(queue) > 0 && queue[0] != to {
+		system := queue[0]
+		queue = queue[1:]
+
+		// visit the neighbours in sorted order for a deterministic route
+		neighbours := make([]string, 0, len(registry.converters[system]))
+		for neighbour := range registry.converters[system] {
+			neighbours = append(neighbours, neighbour)
+		}
+		sort.Strings(neighbours)
+		for _, neighbour := range neighbours {
+			if _, seen := previous[neighbour]; !seen {
+				previous[neighbour] = system
+				queue = append(queue, neighbour)
+			}
+		}
+	}
+	if _, found := previous[to]; !found {
+		return nil, fmt.Errorf("no conversion from %s to %s", from, to)
+	}
+
+	var path []Converter
+	for system := to; system != from; system = previous[system] {
+		path = append([]Converter{registry.converters[previous[system]][system]}, path...)
+	}
+	return path, nil
+}
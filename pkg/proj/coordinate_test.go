@@ -0,0 +1,110 @@
+package proj
+
+import (
+	"fmt"
+	"testing"
+)
+
+// testPoint is a coordinate system registered by the tests to verify that new systems are routed automatically
+type testPoint struct {
+	ll LL
+}
+
+func (p testPoint) ToLL() (LL, error) { return p.ll, nil }
+func (p testPoint) System() string    { return "TEST" }
+func (p testPoint) String() string    { return "TEST " + p.ll.String() }
+
+// unregister removes a coordinate system registered by a test and all converters to and from it
+func unregister(system string) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	delete(registry.converters, system)
+	for _, converters := range registry.converters {
+		delete(converters, system)
+	}
+}
+
+func TestConvert(t *testing.T) {
+
+	Register("TEST", func(ll LL) (Coordinate, error) { return testPoint{ll: ll}, nil })
+	t.Cleanup(func() { unregister("TEST") })
+
+	var tests = []struct {
+		in     Coordinate // in
+		system string     // in
+		out    string     // out
+		err    error      // out
+	}{
+		// positive tests
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, SystemMGRS, "32VNJ9485799059", nil},
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, SystemLL, "57.723661 10.592629", nil},
+		{MGRS("33UUB162700"), SystemLL, "55.641059 12.079514", nil},
+		{MGRS("33UVB98231797"), SystemUSNG, "33U VB 9823 1797", nil},
+		{USNG("32V MJ 81303 12511"), SystemUTM, "32V 481303.00 6312511.00", nil},
+		{LL{Lat: 56.366667, Lon: 8.616667}, SystemMGRS, "32VMH7631946955", nil},
+		{LL{Lat: 56.366667, Lon: 8.616667}, SystemLL, "56.366667 8.616667", nil},
+		{testPoint{ll: LL{Lat: 56.366667, Lon: 8.616667}}, SystemUSNG, "32V MH 76319 46955", nil},
+		{MGRS("33UUB162700"), "TEST", "TEST 55.641059 12.079514", nil},
+		// negative tests
+		{LL{Lat: 86, Lon: 10}, SystemUTM, "<nil>", fmt.Errorf("error <polar regions below 80°S and above 84°N not supported, lat = 86> at conversion from LL")},
+		{LL{Lat: 56, Lon: 190}, SystemMGRS, "<nil>", fmt.Errorf("error <invalid longitude, lon = 190> at conversion from LL")},
+		{MGRS("32ULC9897356497CORRUPT"), SystemUTM, "<nil>", fmt.Errorf("error <uneven number of digits, mgrs = 32ULC9897356497CORRUPT> at conversion from MGRS")},
+		{MGRS("33UUB162700"), "UNKNOWN", "<nil>", fmt.Errorf("unknown coordinate system, system = UNKNOWN")},
+	}
+
+	for _, test := range tests {
+		out, err := ConvertTo(test.in, test.system)
+		function := fmt.Sprintf("ConvertTo(%s, %s)", test.in, test.system)
+		got := fmt.Sprintf("%v %v", out, err)
+		want := fmt.Sprintf("%s %v", test.out, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestConvert_TargetOnly(t *testing.T) {
+
+	// a system registered only as the target of a converter
+	RegisterConverter(SystemMGRS, "TARGET", func(c Coordinate) (Coordinate, error) {
+		ll, err := c.ToLL()
+		return testPoint{ll: ll}, err
+	})
+	t.Cleanup(func() { unregister("TARGET") })
+
+	out, err := ConvertTo(UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, "TARGET")
+	function := "ConvertTo(32V 594857.92 6399059.92, TARGET)"
+	got := fmt.Sprintf("%v %v", out, err)
+	want := "TEST 57.723653 10.592613 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+
+	_, err = ConvertTo(testPoint{}, SystemLL)
+	function = "ConvertTo(TEST 0.000000 0.000000, LL)"
+	got = fmt.Sprintf("%v", err)
+	want = "unknown coordinate system, system = TEST"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+}
+
+func TestConvert_Generic(t *testing.T) {
+
+	utm, err := Convert[UTM](MGRS("33UVB98231797"))
+	function := "Convert[UTM](33UVB98231797)"
+	got := fmt.Sprintf("%s %v", utm, err)
+	want := "33U 498230.00 6117970.00 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+
+	ll, err := Convert[LL](USNG("32V MJ 81303 12511"))
+	function = "Convert[LL](32V MJ 81303 12511)"
+	got = fmt.Sprintf("%s %v", ll, err)
+	want = "56.955828 8.692583 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+}
@@ -66,21 +66,55 @@ Supported conversions:
 
 utm.ToLL()   : converts from UTM to LL
 utm.ToMGRS() : converts from UTM to MGRS
+utm.ToMGRSChecked() : converts from UTM to MGRS, fails when the latitude band cannot be determined
 utm.ToUSNG   : converts from UTM to USNG
+utm.ToMGRSRounded : converts from UTM to MGRS truncating or rounding to the nearest
+utm.ToUSNGRounded : converts from UTM to USNG truncating or rounding to the nearest
 ll.ToUTM()   : converts from LL to UTM
+ll.ToUTMZone : converts from LL to UTM in a given zone and hemisphere
+utm.ToZone   : reprojects UTM into another zone
+utm.ToHemisphere / utm.ToBand : converts between zone+hemisphere (32N) and zone+band (32V) notation
+utm.EPSG / utm.EPSGETRS89 / UTMFromEPSG : maps UTM to and from EPSG codes 326xx, 327xx and 258xx
+ParseUTMBand / ParseUTMHemisphere : parses UTM in band or hemisphere notation
+ParseUTM     : parses UTM as typed by users, labels, units and comma decimals
+ParseLL      : parses latitude longitude in decimal degrees or degrees, minutes and seconds
+ParseGeoURI / geo.String : parses and formats geo URIs (RFC 5870), city.GeoURI returns a shareable link
+NearestCity  : finds the city nearest to a latitude longitude
+Parse / RegisterParser : recognises a coordinate in any registered notation with confidence and alternatives
 ll.ToMGRS()  : converts from LL to MGRS
 mgrs.ToUTM() : converts from MGRS to UTM
 mgrs.ToLL()  : converts from MGRS to LL
+mgrs.Accuracy() / usng.Accuracy() : accuracy of the reference in meters
+mgrs.WithPrecision() : reduces or expands the digits of a MGRS reference
+utm.ToMGRSLettering / mgrs.ToUTMLettering : MGRS in the AA or the legacy AL lettering scheme
+utm.Convergence / ll.Convergence(zone) : meridian convergence, angle from true north to grid north
+utm.ScaleFactor / ll.ScaleFactor(zone) : point scale factor for grid to ground corrections
+utm.GridBearing / utm.TrueBearing : converts between true bearing and grid bearing
 usng.ToLL	 : converts from USNG to LL
 usng.ToMGRS	 : converts from USNG to MGRS
 usng.toUTM   : converts from USNG to UTM
+Convert[T] / ConvertTo : converts any Coordinate to another registered coordinate system
+Register / RegisterConverter : registers coordinate systems and direct converters
+tm.Forward / tm.Inverse : transverse Mercator with any ellipsoid and parameters, UTMProjection and GaussKruger
+helmert.Transform : seven parameter datum shift, e.g. WGS84 to OSGB36 on Airy 1830
+laea.Forward / laea.Inverse : Lambert azimuthal equal area, ETRS89LAEA of the European reference grid
+lcc.Forward / lcc.Inverse : Lambert conformal conic with one or two standard parallels, ETRS89LCC
+ps.Forward / ps.Inverse : polar stereographic variant A and B beyond the 84°N and 80°S of UTM, UPSProjection and NSIDCSeaIceNorth
 
 Data objects:
 
-UTM  : ZoneNumber ZoneLetter Easting Northing
+UTM  : ZoneNumber ZoneLetter Easting Northing Hemisphere
 LL   : Longitude Latitude
 MGRS : String
 USNG : string
+Ellipsoid : Name A InvF, selects the MGRS lettering scheme
+GeoURI : LL Altitude Uncertainty CRS Params
+Coordinate : interface ToLL System String implemented by LL, UTM, MGRS and USNG
+TransverseMercator : Ellipsoid Lon0 Lat0 K0 FalseEasting FalseNorthing
+Helmert : Tx Ty Tz S Rx Ry Rz
+LambertAzimuthalEqualArea : Ellipsoid Lon0 Lat0 FalseEasting FalseNorthing
+LambertConformalConic : Ellipsoid Lon0 Lat0 Lat1 Lat2 K0 FalseEasting FalseNorthing
+PolarStereographic : Ellipsoid Hemisphere Lon0 LatTS K0 FalseEasting FalseNorthing
 
 Abbreviations:
 
@@ -0,0 +1,66 @@
+package proj
+
+// Ellipsoid defines a reference ellipsoid by its semi-major axis and inverse flattening
+/*
+The ellipsoid also selects the MGRS lettering scheme used on maps based on it.
+
+ For WGS84:
+	- A: 6378137.0 meters
+	- InvF: 298.257223563
+
+See also
+ - [Lettering]
+ - https://en.wikipedia.org/wiki/Earth_ellipsoid
+*/
+type Ellipsoid struct {
+	Name string
+	A    float64 // semi-major axis in meters
+	InvF float64 // inverse flattening
+}
+
+// Reference ellipsoids
+var (
+	WGS84             = Ellipsoid{Name: "WGS 84", A: 6378137.0, InvF: 298.257223563}
+	GRS80             = Ellipsoid{Name: "GRS 1980", A: 6378137.0, InvF: 298.257222101}
+	International1924 = Ellipsoid{Name: "International 1924", A: 6378388.0, InvF: 297.0}
+	Bessel1841        = Ellipsoid{Name: "Bessel 1841", A: 6377397.155, InvF: 299.1528128}
+	Clarke1866        = Ellipsoid{Name: "Clarke 1866", A: 6378206.4, InvF: 294.9786982}
+	Clarke1880        = Ellipsoid{Name: "Clarke 1880 (RGS)", A: 6378249.145, InvF: 293.465}
+	Airy1830          = Ellipsoid{Name: "Airy 1830", A: 6377563.396, InvF: 299.3249646}
+	AiryModified1849  = Ellipsoid{Name: "Airy Modified 1849", A: 6377340.189, InvF: 299.3249646}
+)
+
+// String returns the name of the ellipsoid
+func (e Ellipsoid) String() string {
+	return e.Name
+}
+
+// F returns the flattening of the ellipsoid
+func (e Ellipsoid) F() float64 {
+	return 1 / e.InvF
+}
+
+// B returns the semi-minor axis of the ellipsoid in meters
+func (e Ellipsoid) B() float64 {
+	return e.A * (1 - e.F())
+}
+
+// EccSquared returns the square of the first eccentricity of the ellipsoid
+func (e Ellipsoid) EccSquared() float64 {
+	f := e.F()
+	return f * (2 - f)
+}
+
+/*
+Lettering returns the MGRS lettering scheme used on maps based on the ellipsoid.
+
+Maps on Bessel 1841, Clarke 1866 and Clarke 1880 use the legacy AL scheme, all others the modern AA scheme.
+*/
+func (e Ellipsoid) Lettering() Lettering {
+	switch e {
+	case Bessel1841, Clarke1866, Clarke1880:
+		return LetteringAL
+	default:
+		return LetteringAA
+	}
+}
@@ -0,0 +1,39 @@
+package proj
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestEllipsoid(t *testing.T) {
+
+	var tests = []struct {
+		ellipsoid  Ellipsoid // in
+		b          float64   // out
+		eccSquared float64   // out
+		lettering  Lettering // out
+	}{
+		{WGS84, 6356752.314245, 0.00669437999014, LetteringAA},
+		{GRS80, 6356752.314140, 0.00669438002290, LetteringAA},
+		{International1924, 6356911.946128, 0.00672267002233, LetteringAA},
+		{Bessel1841, 6356078.962818, 0.00667437223180, LetteringAL},
+		{Clarke1866, 6356583.800000, 0.00676865799761, LetteringAL},
+		{Clarke1880, 6356514.869550, 0.00680351128285, LetteringAL},
+		{Airy1830, 6356256.909237, 0.00667053999999, LetteringAA},
+		{AiryModified1849, 6356034.447939, 0.00667053999999, LetteringAA},
+	}
+
+	for _, test := range tests {
+		function := fmt.Sprintf("ellipsoid = %s", test.ellipsoid)
+		if got := test.ellipsoid.B(); math.Abs(got-test.b) > 0.001 {
+			t.Errorf("\n%s, B() -> %f != %f\n", function, got, test.b)
+		}
+		if got := test.ellipsoid.EccSquared(); math.Abs(got-test.eccSquared) > 1e-12 {
+			t.Errorf("\n%s, EccSquared() -> %.14f != %.14f\n", function, got, test.eccSquared)
+		}
+		if got := test.ellipsoid.Lettering(); got != test.lettering {
+			t.Errorf("\n%s, Lettering() -> %s != %s\n", function, got, test.lettering)
+		}
+	}
+}
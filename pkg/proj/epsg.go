@@ -0,0 +1,74 @@
+package proj
+
+import "fmt"
+
+// EPSG codes of the UTM projections
+/*
+	- 326zz: WGS 84 / UTM zone zzN
+	- 327zz: WGS 84 / UTM zone zzS
+	- 258zz: ETRS89 / UTM zone zzN, zones 28 - 38
+
+The difference between WGS84 and ETRS89 is below one meter and ignored by the conversions in this package.
+
+ For Danish data delivered in EPSG:25832: UTMFromEPSG(25832, 594857.92, 6399059.92) -> "32N 594857.92 6399059.92"
+*/
+const (
+	epsgWGS84North = 32600
+	epsgWGS84South = 32700
+	epsgETRS89     = 25800
+)
+
+/*
+EPSG returns the EPSG code of the WGS 84 UTM projection of the coordinate, 326zz or 327zz.
+
+	For the city of Skagen: "32V 594857.92 6399059.92" -> 32632
+*/
+func (utm UTM) EPSG() (int, error) {
+
+	if utm.ZoneNumber < 1 || utm.ZoneNumber > 60 {
+		return 0, fmt.Errorf("invalid zone number, zone number = %v", utm.ZoneNumber)
+	}
+	if utm.hemisphere() == South {
+		return epsgWGS84South + utm.ZoneNumber, nil
+	}
+	return epsgWGS84North + utm.ZoneNumber, nil
+}
+
+/*
+EPSGETRS89 returns the EPSG code of the ETRS89 UTM projection of the coordinate, 258zz.
+
+ETRS89 is only defined for the zones 28 to 38 in the northern hemisphere.
+
+	For the city of Skagen: "32V 594857.92 6399059.92" -> 25832
+*/
+func (utm UTM) EPSGETRS89() (int, error) {
+
+	if utm.ZoneNumber < 28 || utm.ZoneNumber > 38 || utm.hemisphere() == South {
+		return 0, fmt.Errorf("no ETRS89 projection for zone %d%s", utm.ZoneNumber, utm.hemisphere())
+	}
+	return epsgETRS89 + utm.ZoneNumber, nil
+}
+
+/*
+UTMFromEPSG returns the UTM coordinate in hemisphere notation of easting and northing in the projection of the EPSG code.
+
+Accepted codes are 32601 - 32660, 32701 - 32760 and 25828 - 25838.
+*/
+func UTMFromEPSG(code int, easting, northing float64) (UTM, error) {
+
+	utm := UTM{Easting: easting, Northing: northing}
+	switch {
+	case code > epsgWGS84North && code <= epsgWGS84North+60:
+		utm.ZoneNumber = code - epsgWGS84North
+		utm.Hemisphere = North
+	case code > epsgWGS84South && code <= epsgWGS84South+60:
+		utm.ZoneNumber = code - epsgWGS84South
+		utm.Hemisphere = South
+	case code >= epsgETRS89+28 && code <= epsgETRS89+38:
+		utm.ZoneNumber = code - epsgETRS89
+		utm.Hemisphere = North
+	default:
+		return UTM{}, fmt.Errorf("unsupported EPSG code, code = %d", code)
+	}
+	return utm, nil
+}
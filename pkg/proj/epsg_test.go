@@ -0,0 +1,71 @@
+package proj
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestUTM_EPSG(t *testing.T) {
+
+	var tests = []struct {
+		utm    UTM   // in
+		epsg   int   // out
+		etrs89 int   // out
+		err    error // out
+	}{
+		// positive tests
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, 32632, 25832, nil},
+		{UTM{ZoneNumber: 33, Hemisphere: North, Easting: 481272.13, Northing: 6105992.14}, 32633, 25833, nil},
+		{UTM{ZoneNumber: 23, ZoneLetter: 'K', Easting: 611733.14, Northing: 7800614.37}, 32723, 0, fmt.Errorf("no ETRS89 projection for zone 23S")},
+		{UTM{ZoneNumber: 18, ZoneLetter: 'T', Easting: 593345, Northing: 4507672}, 32618, 0, fmt.Errorf("no ETRS89 projection for zone 18N")},
+		// negative tests
+		{UTM{ZoneNumber: 61, ZoneLetter: 'U', Easting: 500000, Northing: 6000000}, 0, 0, fmt.Errorf("invalid zone number, zone number = 61")},
+	}
+
+	for _, test := range tests {
+		epsg, err := test.utm.EPSG()
+		etrs89, errETRS89 := test.utm.EPSGETRS89()
+		if err == nil {
+			err = errETRS89
+		}
+		function := fmt.Sprintf("utm = %s, EPSG(), EPSGETRS89()", test.utm)
+		got := fmt.Sprintf("%d %d %v", epsg, etrs89, err)
+		want := fmt.Sprintf("%d %d %v", test.epsg, test.etrs89, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestUTMFromEPSG(t *testing.T) {
+
+	var tests = []struct {
+		code     int     // in
+		easting  float64 // in
+		northing float64 // in
+		utm      UTM     // out
+		err      error   // out
+	}{
+		// positive tests
+		{25832, 863916.93, 6120836.52, UTM{ZoneNumber: 32, Hemisphere: North, Easting: 863916.93, Northing: 6120836.52}, nil},
+		{32632, 594857.92, 6399059.92, UTM{ZoneNumber: 32, Hemisphere: North, Easting: 594857.92, Northing: 6399059.92}, nil},
+		{32723, 611733.14, 7800614.37, UTM{ZoneNumber: 23, Hemisphere: South, Easting: 611733.14, Northing: 7800614.37}, nil},
+		{32601, 500000, 0, UTM{ZoneNumber: 1, Hemisphere: North, Easting: 500000, Northing: 0}, nil},
+		{32760, 500000, 1000000, UTM{ZoneNumber: 60, Hemisphere: South, Easting: 500000, Northing: 1000000}, nil},
+		// negative tests
+		{32600, 500000, 0, UTM{}, fmt.Errorf("unsupported EPSG code, code = 32600")},
+		{32661, 500000, 0, UTM{}, fmt.Errorf("unsupported EPSG code, code = 32661")},
+		{25839, 500000, 0, UTM{}, fmt.Errorf("unsupported EPSG code, code = 25839")},
+		{4326, 56, 8, UTM{}, fmt.Errorf("unsupported EPSG code, code = 4326")},
+	}
+
+	for _, test := range tests {
+		utm, err := UTMFromEPSG(test.code, test.easting, test.northing)
+		function := fmt.Sprintf("UTMFromEPSG(%d, %.2f, %.2f)", test.code, test.easting, test.northing)
+		got := fmt.Sprintf("%#v %v", utm, err)
+		want := fmt.Sprintf("%#v %v", test.utm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
@@ -91,10 +91,14 @@ func ExampleMGRS_ToUTM() {
 func ExampleMGRS_ToLL() {
 
 	mgrs := MGRS("33UUB162700")
-	ll, accuracy, err := mgrs.ToLL()
+	ll, err := mgrs.ToLL()
 	if err != nil {
 		log.Fatalf("error <%v> at mgrs.ToLL()", err)
 	}
+	accuracy, err := mgrs.Accuracy()
+	if err != nil {
+		log.Fatalf("error <%v> at mgrs.Accuracy()", err)
+	}
 	fmt.Printf("Roskilde: %s (with accuracy %d meters) -> %s\n", mgrs, accuracy, ll)
 	// Output:
 	// Roskilde: 33UUB162700 (with accuracy 100 meters) -> 55.641059 12.079514
@@ -130,11 +134,39 @@ func ExampleUSNG_ToUTM() {
 
 func ExampleUSNG_ToLL() {
 	usng := USNG("32V MJ 81303 12511")
-	ll, accuracy, err := usng.ToLL()
+	ll, err := usng.ToLL()
 	if err != nil {
 		log.Fatalf("error <%v> at usng.ToLL()", err)
 	}
+	accuracy, err := usng.Accuracy()
+	if err != nil {
+		log.Fatalf("error <%v> at usng.Accuracy()", err)
+	}
 	fmt.Printf("Thisted: %s (with accuracy %d meters) -> %s\n", usng, accuracy, ll)
 	// Output:
 	// Thisted: 32V MJ 81303 12511 (with accuracy 1 meters) -> 56.955828 8.692583
 }
+
+func ExampleMGRS_WithPrecision() {
+
+	mgrs := MGRS("33UUB162700")
+	expanded, err := mgrs.WithPrecision(10)
+	if err != nil {
+		log.Fatalf("error <%v> at mgrs.WithPrecision()", err)
+	}
+	fmt.Printf("Roskilde: %s -> %s (centre of the 100 meter square)\n", mgrs, expanded)
+	// Output:
+	// Roskilde: 33UUB162700 -> 33UUB16257005 (centre of the 100 meter square)
+}
+
+func ExampleConvert() {
+
+	utm := UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}
+	mgrs, err := Convert[MGRS](utm)
+	if err != nil {
+		log.Fatalf("error <%v> at Convert[MGRS]()", err)
+	}
+	fmt.Printf("Skagen: %s -> %s\n", utm, mgrs)
+	// Output:
+	// Skagen: 32V 594857.92 6399059.92 -> 32VNJ9485799059
+}
@@ -0,0 +1,158 @@
+package proj
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GeoURI defines a geo URI according to RFC 5870
+/*
+The geo URI holds latitude, longitude and optional altitude in WGS84 followed by optional parameters.
+
+	For the city of Copenhagen: "geo:55.6764,12.5683;u=10"
+	- 55.6764 is the latitude
+	- 12.5683 is the longitude
+	- u=10 is the uncertainty in meters
+
+See also
+ - https://www.rfc-editor.org/rfc/rfc5870
+*/
+type GeoURI struct {
+	LL             LL
+	Altitude       float64 // meters above the WGS84 ellipsoid
+	HasAltitude    bool
+	Uncertainty    float64 // meters
+	HasUncertainty bool
+	CRS            string            // coordinate reference system, empty for the default wgs84
+	Params         map[string]string // other parameters
+}
+
+// SystemGeoURI is the name of the geo URI coordinate system
+const SystemGeoURI = "GEO"
+
+func init() {
+	Register(SystemGeoURI, func(ll LL) (Coordinate, error) { return GeoURI{LL: ll}, nil })
+	RegisterParser(SystemGeoURI, func(text string) (Coordinate, float64, error) {
+		geo, err := ParseGeoURI(strings.TrimSpace(text))
+		return geo, 1.0, err
+	})
+}
+
+// geoNumber matches the numbers of the coordinates of a geo URI
+var geoNumber = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+
+/*
+ParseGeoURI parses a geo URI.
+
+Only the coordinate reference system wgs84 is supported. The scheme and parameter names are case-insensitive.
+
+	For the city of Copenhagen: "geo:55.6764,12.5683;u=10"
+*/
+func ParseGeoURI(s string) (GeoURI, error) {
+
+	if len(s) < 4 || !strings.EqualFold(s[:4], "geo:") {
+		return GeoURI{}, fmt.Errorf("invalid geo uri scheme, uri = %s", s)
+	}
+	parts := strings.Split(s[4:], ";")
+
+	coordinates := strings.Split(parts[0], ",")
+	if len(coordinates) < 2 || len(coordinates) > 3 {
+		return GeoURI{}, fmt.Errorf("bad conversion, uri = %s", s)
+	}
+	var values [3]float64
+	for i, coordinate := range coordinates {
+		if !geoNumber.MatchString(coordinate) {
+			return GeoURI{}, fmt.Errorf("bad conversion, uri = %s", s)
+		}
+		values[i], _ = strconv.ParseFloat(coordinate, 64)
+	}
+
+	ll, err := LL{Lat: values[0], Lon: values[1]}.ToLL()
+	if err != nil {
+		return GeoURI{}, err
+	}
+	geo := GeoURI{LL: ll, Altitude: values[2], HasAltitude: len(coordinates) == 3}
+
+	for i, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		key = strings.ToLower(key)
+		value, err = url.PathUnescape(value)
+		if err != nil || key == "" {
+			return GeoURI{}, fmt.Errorf("invalid parameter, uri = %s", s)
+		}
+		switch key {
+		case "crs":
+			if i != 0 {
+				return GeoURI{}, fmt.Errorf("crs must be the first parameter, uri = %s", s)
+			}
+			if !strings.EqualFold(value, "wgs84") {
+				return GeoURI{}, fmt.Errorf("unsupported crs, crs = %s", value)
+			}
+			geo.CRS = strings.ToLower(value)
+		case "u":
+			uncertainty, err := strconv.ParseFloat(value, 64)
+			if err != nil || !geoNumber.MatchString(value) || uncertainty < 0 {
+				return GeoURI{}, fmt.Errorf("invalid uncertainty, u = %s", value)
+			}
+			geo.Uncertainty = uncertainty
+			geo.HasUncertainty = true
+		default:
+			if geo.Params == nil {
+				geo.Params = map[string]string{}
+			}
+			geo.Params[key] = value
+		}
+	}
+	return geo, nil
+}
+
+/*
+String returns the geo URI.
+
+	For the city of Copenhagen: "geo:55.6764,12.5683;u=10"
+*/
+func (geo GeoURI) String() string {
+
+	var sb strings.Builder
+	sb.WriteString("geo:")
+	sb.WriteString(strconv.FormatFloat(geo.LL.Lat, 'f', -1, 64))
+	sb.WriteString(",")
+	sb.WriteString(strconv.FormatFloat(geo.LL.Lon, 'f', -1, 64))
+	if geo.HasAltitude {
+		sb.WriteString(",")
+		sb.WriteString(strconv.FormatFloat(geo.Altitude, 'f', -1, 64))
+	}
+	if geo.CRS != "" {
+		sb.WriteString(";crs=" + geo.CRS)
+	}
+	if geo.HasUncertainty {
+		sb.WriteString(";u=" + strconv.FormatFloat(geo.Uncertainty, 'f', -1, 64))
+	}
+
+	keys := make([]string, 0, len(geo.Params))
+	for key := range geo.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		sb.WriteString(";" + key)
+		if geo.Params[key] != "" {
+			sb.WriteString("=" + url.PathEscape(geo.Params[key]))
+		}
+	}
+	return sb.String()
+}
+
+// System returns the name of the coordinate system
+func (geo GeoURI) System() string {
+	return SystemGeoURI
+}
+
+// ToLL returns the latitude longitude of the geo URI
+func (geo GeoURI) ToLL() (LL, error) {
+	return geo.LL.ToLL()
+}
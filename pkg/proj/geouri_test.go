@@ -0,0 +1,61 @@
+package proj
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseGeoURI(t *testing.T) {
+
+	var tests = []struct {
+		uri string // in
+		geo string // out
+		ll  LL     // out
+		err error  // out
+	}{
+		// positive tests
+		{"geo:55.6764,12.5683", "geo:55.6764,12.5683", LL{Lat: 55.6764, Lon: 12.5683}, nil},
+		{"geo:55.6764,12.5683;u=10", "geo:55.6764,12.5683;u=10", LL{Lat: 55.6764, Lon: 12.5683}, nil},
+		{"GEO:55.6764,12.5683,42.5;U=10", "geo:55.6764,12.5683,42.5;u=10", LL{Lat: 55.6764, Lon: 12.5683}, nil},
+		{"geo:55.6764,12.5683;crs=WGS84;u=0", "geo:55.6764,12.5683;crs=wgs84;u=0", LL{Lat: 55.6764, Lon: 12.5683}, nil},
+		{"geo:-19.887498,-43.932664;name=Belo%20Horizonte", "geo:-19.887498,-43.932664;name=Belo%20Horizonte", LL{Lat: -19.887498, Lon: -43.932664}, nil},
+		// negative tests
+		{"55.6764,12.5683", "geo:0,0", LL{}, fmt.Errorf("invalid geo uri scheme, uri = 55.6764,12.5683")},
+		{"geo:55.6764", "geo:0,0", LL{}, fmt.Errorf("bad conversion, uri = geo:55.6764")},
+		{"geo:55,6764,12,5683", "geo:0,0", LL{}, fmt.Errorf("bad conversion, uri = geo:55,6764,12,5683")},
+		{"geo:95.0,12.5683", "geo:0,0", LL{}, fmt.Errorf("invalid latitude, lat = 95")},
+		{"geo:55.6764,12.5683;crs=etrs89", "geo:0,0", LL{}, fmt.Errorf("unsupported crs, crs = etrs89")},
+		{"geo:55.6764,12.5683;u=10;crs=wgs84", "geo:0,0", LL{}, fmt.Errorf("crs must be the first parameter, uri = geo:55.6764,12.5683;u=10;crs=wgs84")},
+		{"geo:55.6764,12.5683;u=-1", "geo:0,0", LL{}, fmt.Errorf("invalid uncertainty, u = -1")},
+	}
+
+	for _, test := range tests {
+		geo, err := ParseGeoURI(test.uri)
+		ll, _ := geo.ToLL()
+		function := fmt.Sprintf("ParseGeoURI(%q)", test.uri)
+		got := fmt.Sprintf("%s %s %v", geo, ll, err)
+		want := fmt.Sprintf("%s %s %v", test.geo, test.ll, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestCity_GeoURI(t *testing.T) {
+
+	city := City{Name: "Skagen", Geoloc: LL{Lat: 57.72, Lon: 10.58}}
+	function := "city.GeoURI()"
+	got := city.GeoURI().String()
+	want := "geo:57.72,10.58"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+
+	mgrs, err := Convert[MGRS](city.GeoURI())
+	function = "Convert[MGRS](city.GeoURI())"
+	got = fmt.Sprintf("%s %v", mgrs, err)
+	want = "32VNJ9411598634 <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+}
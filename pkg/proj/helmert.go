@@ -0,0 +1,94 @@
+package proj
+
+import (
+	"math"
+)
+
+// Helmert defines a seven parameter Helmert transformation between two datums
+/*
+The transformation shifts, scales and rotates the earth-centred cartesian coordinates of the datum,
+the rotations are in the position vector convention (EPSG method 9606).
+
+	From WGS84 to OSGB36 published by Ordnance Survey:
+	Helmert{Tx: -446.448, Ty: 125.157, Tz: -542.060, S: 20.4894, Rx: -0.1502, Ry: -0.2470, Rz: -0.8421}
+
+The accuracy of such a transformation is a few meters, as the datums of the national mapping agencies are distorted.
+
+See also
+  - https://en.wikipedia.org/wiki/Helmert_transformation
+*/
+type Helmert struct {
+	Tx, Ty, Tz float64 // translations in meters
+	S          float64 // scale change in ppm
+	Rx, Ry, Rz float64 // rotations in arc seconds
+}
+
+/*
+Inverse returns the transformation in the opposite direction.
+
+The negated parameters are accurate to millimeters for the small rotations of geodetic datums.
+*/
+func (h Helmert) Inverse() Helmert {
+	return Helmert{Tx: -h.Tx, Ty: -h.Ty, Tz: -h.Tz, S: -h.S, Rx: -h.Rx, Ry: -h.Ry, Rz: -h.Rz}
+}
+
+/*
+Transform transforms latitude longitude on the ellipsoid of one datum to latitude longitude on the ellipsoid of the other datum.
+
+The height above the ellipsoid is taken as zero.
+
+	For the Caister water tower: "52.658008 1.716074" WGS84 -> "52.657600 1.717944" OSGB36
+*/
+func (h Helmert) Transform(ll LL, from, to Ellipsoid) (LL, error) {
+
+	if _, err := ll.ToLL(); err != nil {
+		return LL{}, err
+	}
+	x, y, z := toCartesian(ll, from)
+
+	s := 1 + h.S*1e-6
+	rx := degToRad(h.Rx / 3600)
+	ry := degToRad(h.Ry / 3600)
+	rz := degToRad(h.Rz / 3600)
+
+	x2 := h.Tx + s*x - rz*y + ry*z
+	y2 := h.Ty + rz*x + s*y - rx*z
+	z2 := h.Tz - ry*x + rx*y + s*z
+
+	return fromCartesian(x2, y2, z2, to), nil
+}
+
+// toCartesian converts latitude longitude on the ellipsoid to earth-centred cartesian coordinates
+func toCartesian(ll LL, e Ellipsoid) (x, y, z float64) {
+
+	lat, lon := degToRad(ll.Lat), degToRad(ll.Lon)
+	e2 := e.EccSquared()
+	nu := e.A / math.Sqrt(1-e2*math.Sin(lat)*math.Sin(lat))
+
+	x = nu * math.Cos(lat) * math.Cos(lon)
+	y = nu * math.Cos(lat) * math.Sin(lon)
+	z = nu * (1 - e2) * math.Sin(lat)
+	return x, y, z
+}
+
+// fromCartesian converts earth-centred cartesian coordinates to latitude longitude on the ellipsoid by Bowring's method
+func fromCartesian(x, y, z float64, e Ellipsoid) LL {
+
+	a, b := e.A, e.B()
+	e2 := e.EccSquared()
+	ep2 := e2 / (1 - e2)
+	p := math.Hypot(x, y)
+	r := math.Hypot(p, z)
+
+	// parametric latitude
+	tanBeta := b * z / (a * p) * (1 + ep2*b/r)
+	sinBeta := tanBeta / math.Sqrt(1+tanBeta*tanBeta)
+	cosBeta := sinBeta / tanBeta
+	if math.IsNaN(cosBeta) {
+		cosBeta = 0
+	}
+
+	lat := math.Atan2(z+ep2*b*sinBeta*sinBeta*sinBeta, p-e2*a*cosBeta*cosBeta*cosBeta)
+	lon := math.Atan2(y, x)
+	return LL{Lat: radToDeg(lat), Lon: radToDeg(lon)}
+}
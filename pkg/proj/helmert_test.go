@@ -0,0 +1,59 @@
+package proj
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestHelmert_Transform(t *testing.T) {
+
+	toOSGB36 := Helmert{Tx: -446.448, Ty: 125.157, Tz: -542.060, S: 20.4894, Rx: -0.1502, Ry: -0.2470, Rz: -0.8421}
+
+	// the Caister water tower in ETRS89 and OSGB36 by the Ordnance Survey transformation OSTN,
+	// the Helmert transformation is accurate to about 5 meters
+	etrs89 := LL{Lat: 52 + 39.0/60 + 28.8282/3600, Lon: 1 + 42.0/60 + 57.8663/3600}
+	osgb36 := LL{Lat: 52 + 39.0/60 + 27.2531/3600, Lon: 1 + 43.0/60 + 4.5177/3600}
+
+	ll, err := toOSGB36.Transform(etrs89, WGS84, Airy1830)
+	if err != nil {
+		t.Fatalf("Transform(%s) -> %v", etrs89, err)
+	}
+	if d := greatCircle(ll, osgb36) * WGS84.A; d > 5 {
+		t.Errorf("\n%s -> %s, %.1f m from %s\n", fmt.Sprintf("Transform(%s)", etrs89), ll, d, osgb36)
+	}
+
+	got := fmt.Sprintf("%.7f %.7f", ll.Lat, ll.Lon)
+	want := "52.6575995 1.7179436"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", fmt.Sprintf("Transform(%s)", etrs89), got, want)
+	}
+
+	back, err := toOSGB36.Inverse().Transform(ll, Airy1830, WGS84)
+	got = fmt.Sprintf("%.6f %.6f %v", back.Lat, back.Lon, err)
+	want = fmt.Sprintf("%.6f %.6f <nil>", etrs89.Lat, etrs89.Lon)
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "Inverse().Transform()", got, want)
+	}
+
+	_, err = toOSGB36.Transform(LL{Lat: 91, Lon: 0}, WGS84, Airy1830)
+	got = fmt.Sprintf("%v", err)
+	want = "invalid latitude, lat = 91"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "Transform(91, 0)", got, want)
+	}
+}
+
+func TestCartesian(t *testing.T) {
+
+	for _, ll := range []LL{{Lat: 53, Lon: -1}, {Lat: 90, Lon: 0}, {Lat: -33.9, Lon: 151.2}, {Lat: 0, Lon: 180}} {
+		x, y, z := toCartesian(ll, WGS84)
+		back := fromCartesian(x, y, z, WGS84)
+
+		function := fmt.Sprintf("fromCartesian(toCartesian(%s))", ll)
+		got := fmt.Sprintf("%.9f %.9f", back.Lat, back.Lon)
+		want := fmt.Sprintf("%.9f %.9f", ll.Lat, ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
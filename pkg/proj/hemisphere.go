@@ -0,0 +1,32 @@
+package proj
+
+import "fmt"
+
+// Hemisphere defines the northern or southern hemisphere of a UTM zone
+/*
+ - North: northings are measured from the equator
+ - South: northings are measured from 10.000.000 meters south of the equator
+*/
+type Hemisphere byte
+
+const (
+	North Hemisphere = 'N'
+	South Hemisphere = 'S'
+)
+
+/*
+String returns the letter of the hemisphere, N or S.
+*/
+func (hemisphere Hemisphere) String() string {
+	return string(hemisphere)
+}
+
+/*
+validate returns an error if the hemisphere is neither North nor South.
+*/
+func (hemisphere Hemisphere) validate() error {
+	if hemisphere != North && hemisphere != South {
+		return fmt.Errorf("invalid hemisphere, hemisphere = %q", byte(hemisphere))
+	}
+	return nil
+}
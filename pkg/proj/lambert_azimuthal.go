@@ -0,0 +1,135 @@
+package proj
+
+import (
+	"fmt"
+	"math"
+)
+
+// LambertAzimuthalEqualArea defines a Lambert azimuthal equal area projection by its ellipsoid and parameters
+/*
+The projection preserves areas, which makes it the projection of statistical grids,
+e.g. ETRS89-LAEA (EPSG:3035) of the European reference grid, see ETRS89LAEA.
+
+The formulas are those of the oblique and equatorial aspects (EPSG method 9820),
+the polar aspects with latitude of origin ±90° are not supported.
+
+See also
+  - https://en.wikipedia.org/wiki/Lambert_azimuthal_equal-area_projection
+  - IOGP, Geomatics Guidance Note 7 part 2, Coordinate Conversions and Transformations including Formulas
+*/
+type LambertAzimuthalEqualArea struct {
+	Ellipsoid     Ellipsoid
+	Lon0          float64 // longitude of origin in degrees
+	Lat0          float64 // latitude of origin in degrees
+	FalseEasting  float64 // meters
+	FalseNorthing float64 // meters
+}
+
+// ETRS89LAEA is the projection of ETRS89-LAEA (EPSG:3035) centred at 52°N 10°E
+var ETRS89LAEA = LambertAzimuthalEqualArea{Ellipsoid: GRS80, Lat0: 52, Lon0: 10, FalseEasting: 4321000, FalseNorthing: 3210000}
+
+// authalic holds the constants of the authalic sphere of an ellipsoid and a latitude of origin
+type authalic struct {
+	e, qp        float64 // eccentricity and q at the pole
+	rq           float64 // radius of the authalic sphere
+	d            float64 // scale of the easting relative to the northing at the origin
+	beta0, phi0  float64 // authalic and geodetic latitude of origin in radians
+	sinB0, cosB0 float64
+}
+
+// q returns the function q of the latitude in radians
+func (k authalic) q(phi float64) float64 {
+	e2 := k.e * k.e
+	sinPhi := math.Sin(phi)
+	return (1 - e2) * (sinPhi/(1-e2*sinPhi*sinPhi) - 1/(2*k.e)*math.Log((1-k.e*sinPhi)/(1+k.e*sinPhi)))
+}
+
+// authalic returns the constants of the authalic sphere of the projection
+func (laea LambertAzimuthalEqualArea) authalic() (authalic, error) {
+
+	if math.Abs(laea.Lat0) >= 90 {
+		return authalic{}, fmt.Errorf("polar aspect not supported, lat0 = %v", laea.Lat0)
+	}
+	k := authalic{e: math.Sqrt(laea.Ellipsoid.EccSquared()), phi0: degToRad(laea.Lat0)}
+	if k.e == 0 {
+		return authalic{}, fmt.Errorf("invalid ellipsoid, ellipsoid = %s", laea.Ellipsoid.Name)
+	}
+	a := laea.Ellipsoid.A
+	k.qp = k.q(math.Pi / 2)
+	k.rq = a * math.Sqrt(k.qp/2)
+	k.beta0 = math.Asin(k.q(k.phi0) / k.qp)
+	k.sinB0, k.cosB0 = math.Sincos(k.beta0)
+	sinPhi0 := math.Sin(k.phi0)
+	k.d = a * math.Cos(k.phi0) / math.Sqrt(1-k.e*k.e*sinPhi0*sinPhi0) / (k.rq * k.cosB0)
+	return k, nil
+}
+
+/*
+Forward projects latitude longitude to easting northing.
+
+The antipode of the origin can not be projected.
+
+	For the city of Skagen in ETRS89-LAEA: "57.720000 10.580000" -> 4355610.79 3846595.43
+*/
+func (laea LambertAzimuthalEqualArea) Forward(ll LL) (easting, northing float64, err error) {
+
+	if _, err := ll.ToLL(); err != nil {
+		return 0, 0, err
+	}
+	k, err := laea.authalic()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	beta := math.Asin(k.q(degToRad(ll.Lat)) / k.qp)
+	sinB, cosB := math.Sincos(beta)
+	sinL, cosL := math.Sincos(degToRad(ll.Lon - laea.Lon0))
+
+	denominator := 1 + k.sinB0*sinB + k.cosB0*cosB*cosL
+	if denominator < 1e-12 {
+		return 0, 0, fmt.Errorf("antipode of the origin, ll = %s", ll)
+	}
+	b := k.rq * math.Sqrt(2/denominator)
+
+	easting = laea.FalseEasting + b*k.d*cosB*sinL
+	northing = laea.FalseNorthing + b/k.d*(k.cosB0*sinB-k.sinB0*cosB*cosL)
+	return easting, northing, nil
+}
+
+/*
+Inverse projects easting northing to latitude longitude.
+
+	For the city of Skagen in ETRS89-LAEA: 4355610.79 3846595.43 -> "57.720000 10.580000"
+*/
+func (laea LambertAzimuthalEqualArea) Inverse(easting, northing float64) (LL, error) {
+
+	k, err := laea.authalic()
+	if err != nil {
+		return LL{}, err
+	}
+	x := easting - laea.FalseEasting
+	y := northing - laea.FalseNorthing
+	rho := math.Hypot(x/k.d, k.d*y)
+	if rho == 0 {
+		return LL{Lat: laea.Lat0, Lon: laea.Lon0}.ToLL()
+	}
+	if rho > 2*k.rq {
+		return LL{}, fmt.Errorf("outside the projection, easting = %v, northing = %v", easting, northing)
+	}
+
+	c := 2 * math.Asin(rho/(2*k.rq))
+	sinC, cosC := math.Sincos(c)
+	beta := math.Asin(cosC*k.sinB0 + k.d*y*sinC*k.cosB0/rho)
+	dLon := math.Atan2(x*sinC, k.d*rho*k.cosB0*cosC-k.d*k.d*y*k.sinB0*sinC)
+
+	// the latitude from the authalic latitude by the series of the EPSG guidance note
+	e2 := k.e * k.e
+	e4, e6 := e2*e2, e2*e2*e2
+	lat := beta +
+		(e2/3+31*e4/180+517*e6/5040)*math.Sin(2*beta) +
+		(23*e4/360+251*e6/3780)*math.Sin(4*beta) +
+		(761*e6/45360)*math.Sin(6*beta)
+
+	lon := math.Remainder(laea.Lon0+radToDeg(dLon), 360)
+	return LL{Lat: radToDeg(lat), Lon: lon}.ToLL()
+}
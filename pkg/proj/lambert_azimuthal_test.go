@@ -0,0 +1,109 @@
+package proj
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestLambertAzimuthalEqualArea_Forward(t *testing.T) {
+
+	polar := LambertAzimuthalEqualArea{Ellipsoid: GRS80, Lat0: 90}
+	sphere := LambertAzimuthalEqualArea{Ellipsoid: Ellipsoid{Name: "sphere", A: 6371000, InvF: math.Inf(1)}, Lat0: 52}
+
+	var tests = []struct {
+		laea LambertAzimuthalEqualArea // in
+		ll   LL                        // in
+		en   string                    // out
+		err  error                     // out
+	}{
+		// positive tests
+		// the example of the EPSG guidance note 7-2
+		{ETRS89LAEA, LL{Lat: 50, Lon: 5}, "3962799.45 2999718.85", nil},
+		{ETRS89LAEA, LL{Lat: 52, Lon: 10}, "4321000.00 3210000.00", nil},
+		{ETRS89LAEA, LL{Lat: 57.72, Lon: 10.58}, "4355610.79 3846595.43", nil},
+		{ETRS89LAEA, LL{Lat: 55.1003, Lon: 14.7065}, "4621316.13 3564803.97", nil},
+		// negative tests
+		{ETRS89LAEA, LL{Lat: -52, Lon: -170}, "0.00 0.00", fmt.Errorf("antipode of the origin, ll = -52.000000 -170.000000")},
+		{ETRS89LAEA, LL{Lat: 95, Lon: 10}, "0.00 0.00", fmt.Errorf("invalid latitude, lat = 95")},
+		{polar, LL{Lat: 80, Lon: 10}, "0.00 0.00", fmt.Errorf("polar aspect not supported, lat0 = 90")},
+		{sphere, LL{Lat: 50, Lon: 5}, "0.00 0.00", fmt.Errorf("invalid ellipsoid, ellipsoid = sphere")},
+	}
+
+	for _, test := range tests {
+		easting, northing, err := test.laea.Forward(test.ll)
+		function := fmt.Sprintf("Forward(%s) lat0 = %v lon0 = %v", test.ll, test.laea.Lat0, test.laea.Lon0)
+		got := fmt.Sprintf("%.2f %.2f %v", easting, northing, err)
+		want := fmt.Sprintf("%s %v", test.en, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		// the series of the inverse latitude is accurate to a millimeter
+		ll, err := test.laea.Inverse(easting, northing)
+		function = fmt.Sprintf("Inverse(%.2f, %.2f) lat0 = %v lon0 = %v", easting, northing, test.laea.Lat0, test.laea.Lon0)
+		got = fmt.Sprintf("%.8f %.8f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.8f %.8f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestLambertAzimuthalEqualArea_Inverse(t *testing.T) {
+
+	var tests = []struct {
+		easting  float64 // in
+		northing float64 // in
+		ll       string  // out
+		err      error   // out
+	}{
+		// positive tests
+		{3962799.45, 2999718.85, "50.000000 5.000000", nil},
+		{4321000, 3210000, "52.000000 10.000000", nil},
+		// negative tests
+		{4321000, 3210000 + 12800000, "0.000000 0.000000", fmt.Errorf("outside the projection, easting = 4.321e+06, northing = 1.601e+07")},
+	}
+
+	for _, test := range tests {
+		ll, err := ETRS89LAEA.Inverse(test.easting, test.northing)
+		function := fmt.Sprintf("ETRS89LAEA.Inverse(%.2f, %.2f)", test.easting, test.northing)
+		got := fmt.Sprintf("%s %v", ll, err)
+		want := fmt.Sprintf("%s %v", test.ll, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestLambertAzimuthalEqualArea_Area validates that the projection preserves the area of a small square on the ellipsoid
+func TestLambertAzimuthalEqualArea_Area(t *testing.T) {
+
+	// the area of a square of 0.01° by 0.01° at latitude φ is M N cos φ dφ dλ
+	a, e2 := GRS80.A, GRS80.EccSquared()
+	for _, ll := range []LL{{Lat: 57.72, Lon: 10.58}, {Lat: 40, Lon: -5}, {Lat: 65, Lon: 30}} {
+		phi := degToRad(ll.Lat + 0.005)
+		w := 1 - e2*math.Sin(phi)*math.Sin(phi)
+		m := a * (1 - e2) / math.Pow(w, 1.5)
+		n := a / math.Sqrt(w)
+		want := m * n * math.Cos(phi) * degToRad(0.01) * degToRad(0.01)
+
+		// shoelace formula of the projected corners
+		corners := []LL{ll, {Lat: ll.Lat, Lon: ll.Lon + 0.01}, {Lat: ll.Lat + 0.01, Lon: ll.Lon + 0.01}, {Lat: ll.Lat + 0.01, Lon: ll.Lon}}
+		area := 0.0
+		for i, corner := range corners {
+			e1, n1, _ := ETRS89LAEA.Forward(corner)
+			e2, n2, _ := ETRS89LAEA.Forward(corners[(i+1)%len(corners)])
+			area += (e1*n2 - e2*n1) / 2
+		}
+
+		function := fmt.Sprintf("area of 0.01° square at %s", ll)
+		got := fmt.Sprintf("%.0f", area)
+		if got != fmt.Sprintf("%.0f", want) {
+			t.Errorf("\n%s -> %s != %.0f\n", function, got, want)
+		}
+	}
+}
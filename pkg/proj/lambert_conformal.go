@@ -0,0 +1,175 @@
+package proj
+
+import (
+	"fmt"
+	"math"
+)
+
+// LambertConformalConic defines a Lambert conformal conic projection by its ellipsoid and parameters
+/*
+The cone intersects the ellipsoid along the standard parallels Lat1 and Lat2, where the scale is true.
+With one standard parallel Lat1, Lat2 and Lat0 are the same latitude and K0 is the scale factor on it (EPSG method 9801),
+with two standard parallels K0 is 1 (EPSG method 9802).
+
+	ETRS89-LCC (EPSG:3034): see ETRS89LCC
+	One standard parallel:  LambertConformalConic{Ellipsoid: Clarke1866, Lat0: 18, Lat1: 18, Lat2: 18, Lon0: -77, K0: 1, ...}
+
+See also
+  - https://en.wikipedia.org/wiki/Lambert_conformal_conic_projection
+  - IOGP, Geomatics Guidance Note 7 part 2, Coordinate Conversions and Transformations including Formulas
+*/
+type LambertConformalConic struct {
+	Ellipsoid     Ellipsoid
+	Lon0          float64 // longitude of origin in degrees
+	Lat0          float64 // latitude of origin in degrees
+	Lat1          float64 // first standard parallel in degrees
+	Lat2          float64 // second standard parallel in degrees
+	K0            float64 // scale factor on the standard parallel, 1 with two standard parallels
+	FalseEasting  float64 // meters
+	FalseNorthing float64 // meters
+}
+
+// ETRS89LCC is the projection of ETRS89-LCC (EPSG:3034) with standard parallels 35°N and 65°N
+var ETRS89LCC = LambertConformalConic{
+	Ellipsoid:     GRS80,
+	Lat0:          52,
+	Lon0:          10,
+	Lat1:          35,
+	Lat2:          65,
+	K0:            1,
+	FalseEasting:  4000000,
+	FalseNorthing: 2800000,
+}
+
+// cone holds the constants of the cone of a projection
+type cone struct {
+	e    float64 // first eccentricity
+	n    float64 // cone constant, sin(lat1) with one standard parallel
+	aF   float64 // a F k0, the radius of the parallel with t = 1
+	rho0 float64 // radius of the latitude of origin
+}
+
+// coneM returns cos φ / sqrt(1 - e² sin² φ) of the latitude in radians
+func coneM(phi, e float64) float64 {
+	sinPhi := math.Sin(phi)
+	return math.Cos(phi) / math.Sqrt(1-e*e*sinPhi*sinPhi)
+}
+
+// coneT returns the function t of the latitude in radians, the tangent of the colatitude on the conformal sphere
+func coneT(phi, e float64) float64 {
+	sinPhi := math.Sin(phi)
+	return math.Tan(math.Pi/4-phi/2) / math.Pow((1-e*sinPhi)/(1+e*sinPhi), e/2)
+}
+
+// cone returns the constants of the cone of the projection
+func (lcc LambertConformalConic) cone() (cone, error) {
+
+	switch {
+	case lcc.K0 <= 0:
+		return cone{}, fmt.Errorf("invalid scale factor, k0 = %v", lcc.K0)
+	case math.Abs(lcc.Lat1) >= 90 || math.Abs(lcc.Lat2) >= 90:
+		return cone{}, fmt.Errorf("invalid standard parallels, lat1 = %v, lat2 = %v", lcc.Lat1, lcc.Lat2)
+	case lcc.Lat1+lcc.Lat2 == 0:
+		return cone{}, fmt.Errorf("standard parallels symmetric about the equator, lat1 = %v, lat2 = %v", lcc.Lat1, lcc.Lat2)
+	}
+
+	e := math.Sqrt(lcc.Ellipsoid.EccSquared())
+	phi1, phi2 := degToRad(lcc.Lat1), degToRad(lcc.Lat2)
+	m1, t1 := coneM(phi1, e), coneT(phi1, e)
+
+	k := cone{e: e, n: math.Sin(phi1)}
+	if lcc.Lat1 != lcc.Lat2 {
+		m2, t2 := coneM(phi2, e), coneT(phi2, e)
+		k.n = (math.Log(m1) - math.Log(m2)) / (math.Log(t1) - math.Log(t2))
+	}
+	k.aF = lcc.Ellipsoid.A * m1 / (k.n * math.Pow(t1, k.n)) * lcc.K0
+	rho0, err := k.rho(lcc.Lat0)
+	if err != nil {
+		return cone{}, fmt.Errorf("invalid latitude of origin, lat0 = %v", lcc.Lat0)
+	}
+	k.rho0 = rho0
+	return k, nil
+}
+
+// rho returns the radius of the parallel of the latitude in degrees, the radius is infinite at the pole opposite the apex
+func (k cone) rho(lat float64) (float64, error) {
+
+	switch apex := math.Copysign(90, k.n); {
+	case math.Abs(lat) > 90:
+		return 0, fmt.Errorf("invalid latitude, lat = %v", lat)
+	case lat == apex:
+		return 0, nil
+	case lat == -apex:
+		return 0, fmt.Errorf("pole opposite the apex of the cone, lat = %v", lat)
+	}
+	return k.aF * math.Pow(coneT(degToRad(lat), k.e), k.n), nil
+}
+
+/*
+Forward projects latitude longitude to easting northing.
+
+The pole opposite the apex of the cone can not be projected.
+
+	For the city of Skagen in ETRS89-LCC: "57.720000 10.580000" -> 4033630.85 3416840.24
+*/
+func (lcc LambertConformalConic) Forward(ll LL) (easting, northing float64, err error) {
+
+	if _, err := ll.ToLL(); err != nil {
+		return 0, 0, err
+	}
+	k, err := lcc.cone()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	rho, err := k.rho(ll.Lat)
+	if err != nil {
+		return 0, 0, err
+	}
+	theta := k.n * degToRad(math.Remainder(ll.Lon-lcc.Lon0, 360))
+
+	easting = lcc.FalseEasting + rho*math.Sin(theta)
+	northing = lcc.FalseNorthing + k.rho0 - rho*math.Cos(theta)
+	return easting, northing, nil
+}
+
+/*
+Inverse projects easting northing to latitude longitude.
+
+	For the city of Skagen in ETRS89-LCC: 4033630.85 3416840.24 -> "57.720000 10.580000"
+*/
+func (lcc LambertConformalConic) Inverse(easting, northing float64) (LL, error) {
+
+	k, err := lcc.cone()
+	if err != nil {
+		return LL{}, err
+	}
+	x := easting - lcc.FalseEasting
+	y := k.rho0 - (northing - lcc.FalseNorthing)
+
+	// for a cone with the apex at the south pole the signs of x and y are reversed
+	rho := math.Copysign(math.Hypot(x, y), k.n)
+	theta := math.Atan2(x, y)
+	if k.n < 0 {
+		theta = math.Atan2(-x, -y)
+	}
+	if rho == 0 {
+		return LL{Lat: math.Copysign(90, k.n), Lon: lcc.Lon0}.ToLL()
+	}
+
+	// solve the latitude of t by fixed point iteration
+	t := math.Pow(rho/k.aF, 1/k.n)
+	phi := math.Pi/2 - 2*math.Atan(t)
+	for range 15 {
+		sinPhi := math.Sin(phi)
+		next := math.Pi/2 - 2*math.Atan(t*math.Pow((1-k.e*sinPhi)/(1+k.e*sinPhi), k.e/2))
+		if math.Abs(next-phi) < 1e-14 {
+			phi = next
+			break
+		}
+		phi = next
+	}
+
+	lon := math.Remainder(lcc.Lon0+radToDeg(theta/k.n), 360)
+	return LL{Lat: radToDeg(phi), Lon: lon}.ToLL()
+}
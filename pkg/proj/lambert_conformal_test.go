@@ -0,0 +1,83 @@
+package proj
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLambertConformalConic_Forward(t *testing.T) {
+
+	dms := func(d, m, s float64) float64 { return d + m/60 + s/3600 }
+	usFoot := 1200.0 / 3937
+
+	// the examples of the EPSG guidance note 7-2, two standard parallels in Texas and one in Jamaica
+	texas := LambertConformalConic{Ellipsoid: Clarke1866, Lat0: dms(27, 50, 0), Lon0: -99, Lat1: dms(28, 23, 0), Lat2: dms(30, 17, 0), K0: 1, FalseEasting: 2000000 * usFoot}
+	jamaica := LambertConformalConic{Ellipsoid: Clarke1866, Lat0: 18, Lat1: 18, Lat2: 18, Lon0: -77, K0: 1, FalseEasting: 250000, FalseNorthing: 150000}
+	// a cone with the apex at the south pole
+	australia := LambertConformalConic{Ellipsoid: GRS80, Lat0: -32, Lat1: -28, Lat2: -36, Lon0: 135, K0: 1, FalseEasting: 1000000, FalseNorthing: 2000000}
+
+	var tests = []struct {
+		lcc LambertConformalConic // in
+		ll  LL                    // in
+		en  string                // out
+		err error                 // out
+	}{
+		// positive tests
+		{texas, LL{Lat: 28.5, Lon: -96}, "903277.799 77650.943", nil},
+		{jamaica, LL{Lat: dms(17, 55, 55.80), Lon: -dms(76, 56, 37.26)}, "255966.582 142493.511", nil},
+		{ETRS89LCC, LL{Lat: 52, Lon: 10}, "4000000.000 2800000.000", nil},
+		{ETRS89LCC, LL{Lat: 57.72, Lon: 10.58}, "4033630.853 3416840.244", nil},
+		{ETRS89LCC, LL{Lat: 90, Lon: 10}, "4000000.000 7701418.870", nil},
+		{australia, LL{Lat: -33.87, Lon: 151.21}, "2491360.902 1680980.289", nil},
+		{australia, LL{Lat: -90, Lon: 135}, "1000000.000 -8183537.683", nil},
+		// negative tests
+		{ETRS89LCC, LL{Lat: -90, Lon: 10}, "0.000 0.000", fmt.Errorf("pole opposite the apex of the cone, lat = -90")},
+		{australia, LL{Lat: 90, Lon: 10}, "0.000 0.000", fmt.Errorf("pole opposite the apex of the cone, lat = 90")},
+		{ETRS89LCC, LL{Lat: 57.72, Lon: 190}, "0.000 0.000", fmt.Errorf("invalid longitude, lon = 190")},
+	}
+
+	for _, test := range tests {
+		easting, northing, err := test.lcc.Forward(test.ll)
+		function := fmt.Sprintf("Forward(%s) lat1 = %v lat2 = %v", test.ll, test.lcc.Lat1, test.lcc.Lat2)
+		got := fmt.Sprintf("%.3f %.3f %v", easting, northing, err)
+		want := fmt.Sprintf("%s %v", test.en, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		ll, err := test.lcc.Inverse(easting, northing)
+		function = fmt.Sprintf("Inverse(%.3f, %.3f) lat1 = %v lat2 = %v", easting, northing, test.lcc.Lat1, test.lcc.Lat2)
+		got = fmt.Sprintf("%.9f %.9f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.9f %.9f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestLambertConformalConic_Invalid(t *testing.T) {
+
+	var tests = []struct {
+		lcc LambertConformalConic // in
+		err error                 // out
+	}{
+		{LambertConformalConic{Ellipsoid: GRS80, Lat1: 35, Lat2: 65}, fmt.Errorf("invalid scale factor, k0 = 0")},
+		{LambertConformalConic{Ellipsoid: GRS80, Lat1: 90, Lat2: 65, K0: 1}, fmt.Errorf("invalid standard parallels, lat1 = 90, lat2 = 65")},
+		{LambertConformalConic{Ellipsoid: GRS80, Lat1: 30, Lat2: -30, K0: 1}, fmt.Errorf("standard parallels symmetric about the equator, lat1 = 30, lat2 = -30")},
+		{LambertConformalConic{Ellipsoid: GRS80, Lat0: -90, Lat1: 35, Lat2: 65, K0: 1}, fmt.Errorf("invalid latitude of origin, lat0 = -90")},
+	}
+
+	for _, test := range tests {
+		_, _, err := test.lcc.Forward(LL{Lat: 52, Lon: 10})
+		_, errInverse := test.lcc.Inverse(0, 0)
+		function := fmt.Sprintf("%+v.Forward/Inverse", test.lcc)
+		got := fmt.Sprintf("%v %v", err, errInverse)
+		want := fmt.Sprintf("%v %v", test.err, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
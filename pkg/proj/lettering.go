@@ -0,0 +1,47 @@
+package proj
+
+// Lettering defines the scheme used for the row letters (northing) of the MGRS 100-km squares
+/*
+The column letters (easting) are the same in both schemes.
+
+  - LetteringAA: the modern scheme, row letters start with A (odd zones) and F (even zones) at the equator
+  - LetteringAL: the legacy scheme of older maps on Bessel 1841, Clarke 1866 and Clarke 1880,
+    row letters start with L (odd zones) and R (even zones) at the equator
+
+See also
+ - [Ellipsoid.Lettering]
+ - https://en.wikipedia.org/wiki/Military_Grid_Reference_System
+*/
+type Lettering int
+
+const (
+	LetteringAA Lettering = iota // modern lettering scheme
+	LetteringAL                  // legacy lettering scheme
+)
+
+// setOriginRowLettersAL defines the row letters (for northing) of the lower left value, per set, in the AL scheme.
+const setOriginRowLettersAL = "LRLRLR"
+
+/*
+String returns the name of the lettering scheme.
+*/
+func (lettering Lettering) String() string {
+	switch lettering {
+	case LetteringAA:
+		return "AA"
+	case LetteringAL:
+		return "AL"
+	default:
+		return "unknown"
+	}
+}
+
+/*
+rowOrigins returns the row letters of the lower left value, per set, for the lettering scheme.
+*/
+func (lettering Lettering) rowOrigins() string {
+	if lettering == LetteringAL {
+		return setOriginRowLettersAL
+	}
+	return setOriginRowLetters
+}
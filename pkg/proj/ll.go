@@ -3,6 +3,11 @@ package proj
 import (
 	"fmt"
 	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/angle"
 )
 
 // LL defines coordinate in Longitude / Latitude
@@ -23,6 +28,141 @@ func (ll LL) String() string {
 	return fmt.Sprintf("%.6f %.6f", ll.Lat, ll.Lon)
 }
 
+// System returns the name of the coordinate system
+func (ll LL) System() string {
+	return SystemLL
+}
+
+/*
+ToLL returns the latitude longitude itself, after validating the range of latitude and longitude.
+*/
+func (ll LL) ToLL() (LL, error) {
+	if ll.Lon < -180 || ll.Lon > 180 {
+		return LL{}, fmt.Errorf("invalid longitude, lon = %v", ll.Lon)
+	}
+	if ll.Lat < -90 || ll.Lat > 90 {
+		return LL{}, fmt.Errorf("invalid latitude, lat = %v", ll.Lat)
+	}
+	return ll, nil
+}
+
+/*
+ParseLL parses latitude longitude in decimal degrees or degrees, minutes and seconds.
+
+The hemisphere may be given by the letters N, S, E and W (or the Danish Ø and V) before or after the value,
+otherwise latitude is first according to ISO-6709 and south and west are negative.
+Both point and comma are accepted as decimal separator.
+
+	"56.366667 8.616667"
+	"56,366667 8,616667"
+	"56.366667, 8.616667"
+	"56°22'N 8°37'E"
+	"N 56° 22' 0\" Ø 8° 37' 0\""
+*/
+func ParseLL(s string) (LL, error) {
+	ll, _, err := parseLL(s)
+	return ll, err
+}
+
+var (
+	llAngle        = `(-?\d+(?:[.,]\d+)?)\s*(?:°\s*(?:(\d+(?:[.,]\d+)?)\s*'\s*(?:(\d+(?:[.,]\d+)?)\s*"\s*)?)?)?`
+	llPrefixLetter = regexp.MustCompile(`([NSEWØV])\s*` + llAngle)
+	llSuffixLetter = regexp.MustCompile(llAngle + `\s*([NSEWØV])`)
+	llPlain        = regexp.MustCompile(llAngle)
+)
+
+/*
+parseLL parses latitude longitude and reports whether the hemispheres were labelled by letters.
+*/
+func parseLL(s string) (LL, bool, error) {
+
+	text := strings.ToUpper(strings.TrimSpace(s))
+	text = strings.NewReplacer("′′", "\"", "''", "\"", "′", "'", "″", "\"", "º", "°", ";", " ").Replace(text)
+	// a single comma without spaces and points separates latitude and longitude, e.g. 56,8
+	if !strings.ContainsAny(text, " .") && strings.Count(text, ",") == 1 {
+		text = strings.Replace(text, ",", " ", 1)
+	}
+
+	var values [2]float64
+	var letters [2]string
+	matched := false
+	for _, style := range []struct {
+		pattern *regexp.Regexp
+		letter  int // index of the letter group, 0 for none
+		first   int // index of the first value group
+	}{{llPrefixLetter, 1, 2}, {llSuffixLetter, 4, 1}, {llPlain, 0, 1}} {
+		matches := style.pattern.FindAllStringSubmatchIndex(text, -1)
+		if len(matches) != 2 || !llConsumed(text, matches) {
+			continue
+		}
+		for i, m := range matches {
+			deg := 0.0
+			scale := 1.0
+			sign := 1.0
+			for group := style.first; group < style.first+3; group++ {
+				if m[2*group] < 0 {
+					continue
+				}
+				field := strings.Replace(text[m[2*group]:m[2*group+1]], ",", ".", 1)
+				if strings.HasPrefix(field, "-") {
+					sign = -1.0
+					field = field[1:]
+				}
+				value, err := strconv.ParseFloat(field, 64)
+				if err != nil {
+					return LL{}, false, fmt.Errorf("error <%v> at strconv.ParseFloat(), ll = %s", err, s)
+				}
+				if scale > 1 && value >= 60 {
+					return LL{}, false, fmt.Errorf("invalid minutes or seconds, ll = %s", s)
+				}
+				deg += value / scale
+				scale *= 60
+			}
+			values[i] = sign * deg
+			if style.letter > 0 {
+				letters[i] = text[m[2*style.letter]:m[2*style.letter+1]]
+			}
+		}
+		matched = true
+		break
+	}
+	if !matched {
+		return LL{}, false, fmt.Errorf("bad conversion, ll = %s", s)
+	}
+
+	labelled := letters[0] != ""
+	lat, lon := values[0], values[1]
+	if labelled {
+		latLetters, lonLetters := "NS", "EWØV"
+		for i, letter := range letters {
+			if strings.Contains("SWV", letter) {
+				values[i] = -values[i]
+			}
+		}
+		switch {
+		case strings.Contains(latLetters, letters[0]) && strings.Contains(lonLetters, letters[1]):
+			lat, lon = values[0], values[1]
+		case strings.Contains(lonLetters, letters[0]) && strings.Contains(latLetters, letters[1]):
+			lat, lon = values[1], values[0]
+		default:
+			return LL{}, false, fmt.Errorf("invalid hemisphere letters, ll = %s", s)
+		}
+	}
+
+	ll, err := LL{Lat: lat, Lon: lon}.ToLL()
+	if err != nil {
+		return LL{}, false, err
+	}
+	return ll, labelled, nil
+}
+
+// llConsumed reports whether the matches cover the text apart from separating spaces and commas
+func llConsumed(text string, matches [][]int) bool {
+	rest := text[:matches[0][0]] + text[matches[0][1]:matches[1][0]] + text[matches[1][1]:]
+	return strings.Trim(rest, " ,") == ""
+}
+
+// validateLL checks latitude longitude against the limits of UTM, the polar regions are projected with PolarStereographic
 func (ll LL) validateLL() (string, error) {
 	if ll.Lon < -180 || ll.Lon > 180 {
 		return "", fmt.Errorf("invalid longitude, lon = %v", ll.Lon)
@@ -53,6 +193,21 @@ func (ll LL) ToMGRS(accuracy int) (MGRS, error) {
 	return mgrs, nil
 }
 
+/*
+ToMGRSRounded converts latitude longitude to MGRS using the given rounding mode.
+
+The accuracy holds the wanted accuracy in meters. Possible values are 1, 10, 100, 1000, 10.000 or 100.000 meters.
+*/
+func (ll LL) ToMGRSRounded(accuracy int, rounding Rounding) (MGRS, error) {
+
+	str, err := ll.validateLL()
+	if err != nil {
+		return MGRS(str), err
+	}
+	utm := ll.ToUTM()
+	return utm.ToMGRSRounded(accuracy, rounding), nil
+}
+
 /*
 ToUSNG converts latitude longitude to USNG.
 
@@ -67,21 +222,81 @@ func (ll LL) ToUSNG(accuracy int) (USNG, error) {
 	return utm.ToUSNG(accuracy), nil
 }
 
+/*
+ToUSNGRounded converts latitude longitude to USNG using the given rounding mode.
+
+The accuracy holds the wanted accuracy in meters. Possible values are 1, 10, 100, 1000, 10.000 or 100.000 meters.
+*/
+func (ll LL) ToUSNGRounded(accuracy int, rounding Rounding) (USNG, error) {
+	str, err := ll.validateLL()
+	if err != nil {
+		return USNG(str), err
+	}
+	utm := ll.ToUTM()
+	return utm.ToUSNGRounded(accuracy, rounding), nil
+}
+
 /*
 ToUTM converts latitude longitude to UTM.
+
+The zone is chosen automatically including the special zones for Norway and Svalbard.
 */
 func (ll LL) ToUTM() UTM {
 
+	hemisphere := North
+	if ll.Lat < 0.0 {
+		hemisphere = South
+	}
+	return ll.toUTM(ll.zoneNumber(), hemisphere)
+}
+
+/*
+ToUTMZone converts latitude longitude to UTM in the given zone and hemisphere.
+
+Use it when data is delivered in a fixed zone, e.g. Danish data in zone 32 (EPSG:25832) including Bornholm,
+which naturally falls in zone 33. The point is projected with the Krüger series of [TransverseMercator],
+which keeps millimeter accuracy far outside the zone.
+
+ToUTM uses a series of lower order. In the natural zone of the point the two differ by less than a millimeter,
+which may change the last decimal when easting and northing are written to centimeters.
+
+	For Rønne on Bornholm: "55.100300 14.706500" -> ToUTMZone(32, North) -> "32U 863916.93 6120836.52"
+*/
+func (ll LL) ToUTMZone(zoneNumber int, hemisphere Hemisphere) (UTM, error) {
+
+	if _, err := ll.validateLL(); err != nil {
+		return UTM{}, err
+	}
+	if zoneNumber < 1 || zoneNumber > 60 {
+		return UTM{}, fmt.Errorf("invalid zone number, zone number = %v", zoneNumber)
+	}
+	if err := hemisphere.validate(); err != nil {
+		return UTM{}, err
+	}
+	if (hemisphere == South) != (ll.Lat < 0.0) {
+		return UTM{}, fmt.Errorf("latitude not in hemisphere %s, lat = %v", hemisphere, ll.Lat)
+	}
+
+	tm, err := UTMProjection(zoneNumber, hemisphere)
+	if err != nil {
+		return UTM{}, err
+	}
+	easting, northing, err := tm.Forward(ll)
+	if err != nil {
+		return UTM{}, err
+	}
+	return UTM{ZoneNumber: zoneNumber, ZoneLetter: getLetterDesignator(ll.Lat), Easting: easting, Northing: northing}, nil
+}
+
+/*
+zoneNumber returns the UTM zone of latitude longitude including the special zones for Norway and Svalbard.
+*/
+func (ll LL) zoneNumber() int {
+
 	Lat := ll.Lat
 	Long := ll.Lon
-	a := 6378137.0           //ellip.radius;
-	eccSquared := 0.00669438 //ellip.eccsq;
-	k0 := 0.9996
-	LatRad := degToRad(Lat)
-	LongRad := degToRad(Long)
 
-	ZoneNumber := 0 // (int)
-	ZoneNumber = int(math.Floor((Long+180)/6) + 1)
+	ZoneNumber := int(math.Floor((Long+180)/6) + 1)
 
 	// make sure the longitude 180.00 is in Zone 60
 	if Long == 180 {
@@ -106,6 +321,22 @@ func (ll LL) ToUTM() UTM {
 		}
 	}
 
+	return ZoneNumber
+}
+
+/*
+toUTM projects latitude longitude to UTM in the given zone and hemisphere.
+*/
+func (ll LL) toUTM(ZoneNumber int, hemisphere Hemisphere) UTM {
+
+	Lat := ll.Lat
+	Long := ll.Lon
+	a := 6378137.0           //ellip.radius;
+	eccSquared := 0.00669438 //ellip.eccsq;
+	k0 := 0.9996
+	LatRad := degToRad(Lat)
+	LongRad := degToRad(Long)
+
 	LongOrigin := (ZoneNumber-1)*6 - 180 + 3 // +3 puts origin in middle of zone
 	LongOriginRad := degToRad(float64(LongOrigin))
 
@@ -121,7 +352,7 @@ func (ll LL) ToUTM() UTM {
 	UTMEasting := (k0*N*(A+(1-T+C)*A*A*A/6.0+(5-18*T+T*T+72*C-58*eccPrimeSquared)*A*A*A*A*A/120.0) + 500000.0)
 
 	UTMNorthing := (k0 * (M + N*math.Tan(LatRad)*(A*A/2+(5-T+9*C+4*C*C)*A*A*A*A/24.0+(61-58*T+T*T+600*C-330*eccPrimeSquared)*A*A*A*A*A*A/720.0)))
-	if Lat < 0.0 {
+	if hemisphere == South {
 		UTMNorthing += 10000000.0 // 10.000.000 meters offset for the Southern Hemisphere
 	}
 
@@ -133,3 +364,35 @@ func (ll LL) ToUTM() UTM {
 
 	return utm
 }
+
+/*
+Convergence returns the meridian convergence at latitude longitude in the given UTM zone.
+
+The convergence is the angle from true north to grid north, positive when grid north is east of true north.
+
+	For the city of Skagen in zone 32: 1.346639°
+*/
+func (ll LL) Convergence(zoneNumber int) (angle.Angle, error) {
+
+	if zoneNumber < 1 || zoneNumber > 60 {
+		return 0, fmt.Errorf("invalid zone number, zone number = %v", zoneNumber)
+	}
+	dLon := degToRad(ll.Lon - centralMeridian(zoneNumber))
+	return angle.FromRadians(convergence(degToRad(ll.Lat), dLon)), nil
+}
+
+/*
+ScaleFactor returns the point scale factor at latitude longitude in the given UTM zone.
+
+The scale factor is the ratio of a distance in the grid to the same distance on the ellipsoid.
+
+	For the city of Skagen in zone 32: 0.999710
+*/
+func (ll LL) ScaleFactor(zoneNumber int) (float64, error) {
+
+	if zoneNumber < 1 || zoneNumber > 60 {
+		return 0, fmt.Errorf("invalid zone number, zone number = %v", zoneNumber)
+	}
+	dLon := degToRad(ll.Lon - centralMeridian(zoneNumber))
+	return scaleFactor(degToRad(ll.Lat), dLon), nil
+}
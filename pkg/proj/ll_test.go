@@ -2,6 +2,7 @@ package proj
 
 import (
 	"fmt"
+	"math"
 	"testing"
 )
 
@@ -94,3 +95,187 @@ func TestLL_ToUSNG(t *testing.T) {
 		}
 	}
 }
+
+func TestLL_ToMGRSRounded(t *testing.T) {
+
+	var tests = []struct {
+		ll       LL       // in
+		accuracy int      // in
+		rounding Rounding // in
+		mgrs     MGRS     // out
+		err      error    // out
+	}{
+		// positive tests
+		{LL{Lat: 51.95, Lon: 7.53}, 1, Truncate, "32ULC9897356497", nil},
+		{LL{Lat: 51.95, Lon: 7.53}, 1, Nearest, "32ULC9897456498", nil},
+		{LL{Lat: 51.95, Lon: 7.53}, 100, Nearest, "32ULC990565", nil},
+		// negative tests
+		{LL{Lat: 51.95, Lon: 188.53}, 100, Nearest, "", fmt.Errorf("invalid longitude, lon = 188.53")},
+		{LL{Lat: 88.95, Lon: 7.53}, 100, Nearest, "", fmt.Errorf("polar regions below 80°S and above 84°N not supported, lat = 88.95")},
+	}
+
+	for _, test := range tests {
+		mgrs, err := test.ll.ToMGRSRounded(test.accuracy, test.rounding)
+		function := fmt.Sprintf("ll = %s, ll.ToMGRSRounded(%d, %s)", test.ll, test.accuracy, test.rounding)
+		got := fmt.Sprintf("%s %v", mgrs, err)
+		want := fmt.Sprintf("%s %v", test.mgrs, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestLL_ToUSNGRounded(t *testing.T) {
+
+	var tests = []struct {
+		ll       LL       // in
+		accuracy int      // in
+		rounding Rounding // in
+		usng     USNG     // out
+		err      error    // out
+	}{
+		// positive tests
+		{LL{Lat: 51.95, Lon: 7.53}, 1, Nearest, "32U LC 98974 56498", nil},
+		{LL{Lat: 51.95, Lon: 7.53}, 100, Nearest, "32U LC 990 565", nil},
+		// negative tests
+		{LL{Lat: 51.95, Lon: 188.53}, 100, Nearest, "", fmt.Errorf("invalid longitude, lon = 188.53")},
+	}
+
+	for _, test := range tests {
+		usng, err := test.ll.ToUSNGRounded(test.accuracy, test.rounding)
+		function := fmt.Sprintf("ll = %s, ll.ToUSNGRounded(%d, %s)", test.ll, test.accuracy, test.rounding)
+		got := fmt.Sprintf("%s %v", usng, err)
+		want := fmt.Sprintf("%s %v", test.usng, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestLL_Convergence(t *testing.T) {
+
+	var tests = []struct {
+		ll          LL      // in
+		zoneNumber  int     // in
+		convergence float64 // out, degrees
+		scaleFactor float64 // out
+		err         error   // out
+	}{
+		// positive tests
+		{LL{Lat: 57.723661, Lon: 10.592629}, 32, 1.346639, 0.999710, nil},
+		{LL{Lat: 55.058337, Lon: 8.829244}, 32, -0.139975, 0.999601, nil},
+		{LL{Lat: -33.857001, Lon: 151.214998}, 56, 0.994689, 0.999936, nil},
+		{LL{Lat: 60.0, Lon: 4.0}, 32, -4.332888, 1.000552, nil},
+		{LL{Lat: 55.1, Lon: 14.9}, 32, 4.844532, 1.001337, nil}, // Bornholm in zone 32
+		// negative tests
+		{LL{Lat: 55.1, Lon: 14.9}, 61, 0.0, 0.0, fmt.Errorf("invalid zone number, zone number = 61")},
+	}
+
+	for _, test := range tests {
+		gamma, err := test.ll.Convergence(test.zoneNumber)
+		k, _ := test.ll.ScaleFactor(test.zoneNumber)
+		function := fmt.Sprintf("ll = %s, Convergence(%d), ScaleFactor(%d)", test.ll, test.zoneNumber, test.zoneNumber)
+		got := fmt.Sprintf("%.6f %.6f %v", gamma.Degrees(), k, err)
+		want := fmt.Sprintf("%.6f %.6f %v", test.convergence, test.scaleFactor, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestLL_ToUTMZone(t *testing.T) {
+
+	var tests = []struct {
+		ll         LL         // in
+		zoneNumber int        // in
+		hemisphere Hemisphere // in
+		utm        UTM        // out
+		err        error      // out
+	}{
+		// positive tests
+		{LL{Lat: 55.1003, Lon: 14.7065}, 32, North, UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 863916.93, Northing: 6120836.52}, nil}, // Rønne in zone 32
+		{LL{Lat: 55.1003, Lon: 14.7065}, 33, North, UTM{ZoneNumber: 33, ZoneLetter: 'U', Easting: 481272.13, Northing: 6105992.14}, nil},
+		{LL{Lat: 60.0, Lon: 4.0}, 31, North, UTM{ZoneNumber: 31, ZoneLetter: 'V', Easting: 555776.27, Northing: 6651832.74}, nil}, // Norway in its natural zone
+		{LL{Lat: -19.887495, Lon: -43.932663}, 23, South, UTM{ZoneNumber: 23, ZoneLetter: 'K', Easting: 611733.14, Northing: 7800614.36}, nil},
+		// negative tests
+		{LL{Lat: -19.887495, Lon: -43.932663}, 23, North, UTM{}, fmt.Errorf("latitude not in hemisphere N, lat = -19.887495")},
+		{LL{Lat: 55.1003, Lon: 14.7065}, 0, North, UTM{}, fmt.Errorf("invalid zone number, zone number = 0")},
+		{LL{Lat: 55.1003, Lon: 14.7065}, 32, 'X', UTM{}, fmt.Errorf("invalid hemisphere, hemisphere = 'X'")},
+		{LL{Lat: 88.95, Lon: 7.53}, 32, North, UTM{}, fmt.Errorf("polar regions below 80°S and above 84°N not supported, lat = 88.95")},
+	}
+
+	for _, test := range tests {
+		utm, err := test.ll.ToUTMZone(test.zoneNumber, test.hemisphere)
+		function := fmt.Sprintf("ll = %s, ToUTMZone(%d, %s)", test.ll, test.zoneNumber, test.hemisphere)
+		got := fmt.Sprintf("%s %v", utm, err)
+		want := fmt.Sprintf("%s %v", test.utm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestLL_ToUTMZoneNatural compares the Krüger series of ToUTMZone with the series of lower order of ToUTM
+// in the natural zone, where they differ by less than a millimeter
+func TestLL_ToUTMZoneNatural(t *testing.T) {
+
+	var tests = []struct {
+		ll LL // in
+	}{
+		{LL{Lat: 57.72, Lon: 10.58}},
+		{LL{Lat: -19.887495, Lon: -43.932663}},
+		{LL{Lat: 0.5, Lon: 5.99}},
+		{LL{Lat: -70.5, Lon: 12.0}},
+		{LL{Lat: 83.9, Lon: 41.99}},
+	}
+
+	for _, test := range tests {
+		utm := test.ll.ToUTM()
+		zone, err := test.ll.ToUTMZone(utm.ZoneNumber, utm.ToHemisphere().Hemisphere)
+		function := fmt.Sprintf("ll = %s, ToUTMZone(%d)", test.ll, utm.ZoneNumber)
+		got := fmt.Sprintf("%t %v", math.Hypot(zone.Easting-utm.Easting, zone.Northing-utm.Northing) < 0.001, err)
+		want := "true <nil>"
+		if got != want {
+			t.Errorf("\n%s -> %s != %s, ToUTM() = %s, ToUTMZone() = %s\n", function, got, want, utm, zone)
+		}
+	}
+}
+
+func TestParseLL(t *testing.T) {
+
+	var tests = []struct {
+		text string // in
+		ll   LL     // out
+		err  error  // out
+	}{
+		// positive tests
+		{"56.366667 8.616667", LL{Lat: 56.366667, Lon: 8.616667}, nil},
+		{"56,366667 8,616667", LL{Lat: 56.366667, Lon: 8.616667}, nil},
+		{"56.366667, 8.616667", LL{Lat: 56.366667, Lon: 8.616667}, nil},
+		{"56.366667;8.616667", LL{Lat: 56.366667, Lon: 8.616667}, nil},
+		{"56,8", LL{Lat: 56, Lon: 8}, nil},
+		{"56°22'N 8°37'E", LL{Lat: 56.366667, Lon: 8.616667}, nil},
+		{"N 56° 22' 0\" Ø 8° 37' 0\"", LL{Lat: 56.366667, Lon: 8.616667}, nil},
+		{"8°37'E 56°22'N", LL{Lat: 56.366667, Lon: 8.616667}, nil},
+		{"56°22′30″N 8°37′12.5″E", LL{Lat: 56.375, Lon: 8.620139}, nil},
+		{"19.887498S 43.932664W", LL{Lat: -19.887498, Lon: -43.932664}, nil},
+		{"-19.887498 -43.932664", LL{Lat: -19.887498, Lon: -43.932664}, nil},
+		// negative tests
+		{"33UUB162700", LL{}, fmt.Errorf("bad conversion, ll = 33UUB162700")},
+		{"32V 594857 6399059", LL{}, fmt.Errorf("bad conversion, ll = 32V 594857 6399059")},
+		{"56N 8N", LL{}, fmt.Errorf("invalid hemisphere letters, ll = 56N 8N")},
+		{"56.1 190", LL{}, fmt.Errorf("invalid longitude, lon = 190")},
+		{"56°60'N 8°37'E", LL{}, fmt.Errorf("invalid minutes or seconds, ll = 56°60'N 8°37'E")},
+		{"56°22'75\"N 8°37'E", LL{}, fmt.Errorf("invalid minutes or seconds, ll = 56°22'75\"N 8°37'E")},
+	}
+
+	for _, test := range tests {
+		ll, err := ParseLL(test.text)
+		function := fmt.Sprintf("ParseLL(%q)", test.text)
+		got := fmt.Sprintf("%s %v", ll, err)
+		want := fmt.Sprintf("%s %v", test.ll, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
@@ -26,6 +26,9 @@ See also
 */
 type MGRS string
 
+// mgrsFormat is the layout of zone number, zone letter, 100-km grid letters, easting and northing
+const mgrsFormat = "%d%s%s%s%s"
+
 // String returns the stringified MGRS object
 /*
 For the city of Roskilde: "33UUB162700"
@@ -34,22 +37,86 @@ func (mgrs MGRS) String() string {
 	return string(mgrs)
 }
 
+// System returns the name of the coordinate system
+func (mgrs MGRS) System() string {
+	return SystemMGRS
+}
+
 /*
 ToLL converts MGRS to latitude longitude.
+
+The latitude longitude denotes the south-west corner of the square, use Accuracy for the size of the square.
 */
-func (mgrs MGRS) ToLL() (LL, int, error) {
+func (mgrs MGRS) ToLL() (LL, error) {
 
-	utm, accuracy, err := mgrs.ToUTM()
+	utm, _, err := mgrs.ToUTM()
 	if err != nil {
-		return LL{}, 0, fmt.Errorf("error <%v> at mgrs.ToUTM()", err)
+		return LL{}, fmt.Errorf("error <%v> at mgrs.ToUTM()", err)
 	}
 
 	ll, err := utm.ToLL()
 	if err != nil {
-		return LL{}, 0, fmt.Errorf("error <%v> at utm.ToLL(), utm = %#v", err, utm)
+		return LL{}, fmt.Errorf("error <%v> at utm.ToLL(), utm = %s", err, utm)
 	}
 
-	return ll, accuracy, nil
+	return ll, nil
+}
+
+/*
+Accuracy returns the accuracy of the MGRS reference in meters.
+
+	For the city of Roskilde: "33UUB162700" -> 100
+*/
+func (mgrs MGRS) Accuracy() (int, error) {
+
+	_, accuracy, err := mgrs.ToUTM()
+	if err != nil {
+		return 0, fmt.Errorf("error <%v> at mgrs.ToUTM()", err)
+	}
+	// a reference without digits denotes the 100-km square
+	if accuracy == 0 {
+		accuracy = 100000
+	}
+	return accuracy, nil
+}
+
+/*
+WithPrecision changes the precision of the MGRS reference.
+
+The accuracy holds the wanted accuracy in meters. Possible values are 1, 10, 100, 1000, 10.000 or 100.000 meters.
+
+Reducing the precision truncates the reference to the square containing the original square.
+Expanding the precision returns the square at the centre of the original square rather than the south-west corner.
+
+	For the city of Roskilde: "33UUB162700"
+	- WithPrecision(1000) -> "33UUB1670"
+	- WithPrecision(1) -> "33UUB1625070050"
+*/
+func (mgrs MGRS) WithPrecision(accuracy int) (MGRS, error) {
+
+	switch accuracy {
+	case 1, 10, 100, 1000, 10000, 100000:
+	default:
+		return "", fmt.Errorf("invalid accuracy, accuracy = %d", accuracy)
+	}
+
+	utm, current, err := mgrs.ToUTM()
+	if err != nil {
+		return "", fmt.Errorf("error <%v> at mgrs.ToUTM()", err)
+	}
+
+	// a reference without digits denotes the 100-km square
+	if current == 0 {
+		current = 100000
+	}
+
+	if accuracy < current {
+		utm.Easting += float64(current) / 2
+		utm.Northing += float64(current) / 2
+	}
+
+	grid, err := utm.buildGrid(accuracy, Truncate, LetteringAA, mgrsFormat)
+	return MGRS(grid), err
 }
 
 // ToUSNG converts MGRS to USNG.
@@ -69,6 +136,15 @@ func (mgrs MGRS) ToUSNG() USNG {
 ToUTM converts MGRS to UTM.
 */
 func (mgrs MGRS) ToUTM() (UTM, int, error) {
+	return mgrs.ToUTMLettering(LetteringAA)
+}
+
+/*
+ToUTMLettering converts MGRS to UTM using the given lettering scheme.
+
+Use the lettering of the ellipsoid the map is based on, e.g. Bessel1841.Lettering() for grid references from older maps.
+*/
+func (mgrs MGRS) ToUTMLettering(lettering Lettering) (UTM, int, error) {
 
 	mgrsTmp := string(mgrs)
 	if mgrs == "" {
@@ -119,7 +195,7 @@ func (mgrs MGRS) ToUTM() (UTM, int, error) {
 		return UTM{}, 0, fmt.Errorf("error <%v> at getEastingFromChar()", err)
 	}
 
-	north100k, err := getNorthingFromChar(hunK[1], set)
+	north100k, err := getNorthingFromChar(hunK[1], set, lettering)
 	if err != nil {
 		return UTM{}, 0, fmt.Errorf("error <%v> at getNorthingFromChar()", err)
 	}
@@ -59,7 +59,8 @@ func TestMGRS_ToLL(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		ll, accuracy, err := test.mgrs.ToLL()
+		ll, err := test.mgrs.ToLL()
+		accuracy, _ := test.mgrs.Accuracy()
 		function := fmt.Sprintf("mgrs = %s, mgrs.ToLL()", test.mgrs)
 		got := fmt.Sprintf("%s %d %v", ll, accuracy, err)
 		want := fmt.Sprintf("%s %d %v", test.ll, test.accuracy, test.err)
@@ -90,3 +91,67 @@ func TestMGRS_ToUSNGS(t *testing.T) {
 		}
 	}
 }
+
+func TestMGRS_WithPrecision(t *testing.T) {
+
+	var tests = []struct {
+		mgrs     MGRS  // in
+		accuracy int   // in
+		result   MGRS  // out
+		err      error // out
+	}{
+		// positive tests
+		{"33UUB162700", 100, "33UUB162700", nil},
+		{"33UUB162700", 1000, "33UUB1670", nil},
+		{"33UUB162700", 10000, "33UUB17", nil},
+		{"33UUB162700", 100000, "33UUB", nil},
+		{"33UUB162700", 10, "33UUB16257005", nil},
+		{"33UUB162700", 1, "33UUB1625070050", nil},
+		{"33UUB", 1000, "33UUB5050", nil},
+		{"32ULC9897356497", 1, "32ULC9897356497", nil},
+		// negative tests
+		{"33UUB162700", 5, "", fmt.Errorf("invalid accuracy, accuracy = 5")},
+		{"", 10, "", fmt.Errorf("error <invalid empty mgrs string> at mgrs.ToUTM()")},
+	}
+
+	for _, test := range tests {
+		result, err := test.mgrs.WithPrecision(test.accuracy)
+		function := fmt.Sprintf("mgrs = %s, mgrs.WithPrecision(%d)", test.mgrs, test.accuracy)
+		got := fmt.Sprintf("%s %v", result, err)
+		want := fmt.Sprintf("%s %v", test.result, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestMGRS_ToUTMLettering(t *testing.T) {
+
+	var tests = []struct {
+		mgrs      MGRS      // in
+		lettering Lettering // in
+		utm       UTM       // out
+		accuracy  int       // out
+		err       error     // out
+	}{
+		// positive tests
+		{"32ULN9897356497", LetteringAL, UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 398973, Northing: 5756497}, 1, nil},
+		{"33UVM98231797", LetteringAL, UTM{ZoneNumber: 33, ZoneLetter: 'U', Easting: 498230, Northing: 6117970}, 10, nil},
+		{"31UDE4825111943", LetteringAL, UTM{ZoneNumber: 31, ZoneLetter: 'U', Easting: 448251, Northing: 5411943}, 1, nil},
+		{"30NYR6799300000", LetteringAL, UTM{ZoneNumber: 30, ZoneLetter: 'N', Easting: 767993, Northing: 0}, 1, nil},
+		{"23KPJ1173300614", LetteringAL, UTM{ZoneNumber: 23, ZoneLetter: 'K', Easting: 611733, Northing: 7800614}, 1, nil},
+		{"32ULC9897356497", LetteringAA, UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 398973, Northing: 5756497}, 1, nil},
+		// negative tests
+		{"32ULW9897356497", LetteringAL, UTM{}, 0, fmt.Errorf("error <invalid northing, char = 87> at getNorthingFromChar()")},
+	}
+
+	for _, test := range tests {
+		utm, accuracy, err := test.mgrs.ToUTMLettering(test.lettering)
+		function := fmt.Sprintf("mgrs = %s, ToUTMLettering(%s)", test.mgrs, test.lettering)
+		got := fmt.Sprintf("%s %d %v", utm, accuracy, err)
+		want := fmt.Sprintf("%s %d %v", test.utm, test.accuracy, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
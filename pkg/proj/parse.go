@@ -0,0 +1,204 @@
+package proj
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Parser parses text in the notation of a coordinate system
+/*
+The confidence between 0 and 1 tells how certain the parser is that the text is in its notation,
+e.g. latitude longitude labelled with hemisphere letters is more certain than two plain numbers.
+*/
+type Parser func(text string) (Coordinate, float64, error)
+
+// Candidate is a coordinate recognised by Parse
+type Candidate struct {
+	Coordinate Coordinate
+	System     string
+	Confidence float64
+}
+
+// Parsed is the result of Parse, the most probable candidate followed by the alternatives
+type Parsed struct {
+	Candidate
+	Alternatives []Candidate
+}
+
+// reader parses text that may have more than one reading in the notation of a coordinate system
+type reader func(text string) ([]Candidate, error)
+
+// parsers holds the registered parsers, system -> reader
+var parsers = struct {
+	sync.RWMutex
+	bySystem map[string]reader
+}{bySystem: map[string]reader{}}
+
+func init() {
+	RegisterParser(SystemLL, func(text string) (Coordinate, float64, error) {
+		ll, labelled, err := parseLL(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		if labelled {
+			return ll, 1.0, nil
+		}
+		return ll, 0.8, nil
+	})
+	registerReader(SystemUTM, readUTM)
+	RegisterParser(SystemMGRS, parseMGRS)
+	RegisterParser(SystemUSNG, parseUSNG)
+}
+
+/*
+RegisterParser registers the parser of a coordinate system for Parse.
+
+Packages with new coordinate systems register their parser in their init function.
+*/
+func RegisterParser(system string, parser Parser) {
+	registerReader(system, func(text string) ([]Candidate, error) {
+		c, confidence, err := parser(text)
+		if err != nil {
+			return nil, err
+		}
+		return []Candidate{{Coordinate: c, System: system, Confidence: confidence}}, nil
+	})
+}
+
+// registerReader registers the reader of a coordinate system for Parse
+func registerReader(system string, read reader) {
+	parsers.Lock()
+	defer parsers.Unlock()
+
+	parsers.bySystem[system] = read
+}
+
+// ParserSystems returns the sorted names of the coordinate systems recognised by Parse
+func ParserSystems() []string {
+	parsers.RLock()
+	defer parsers.RUnlock()
+
+	systems := make([]string, 0, len(parsers.bySystem))
+	for system := range parsers.bySystem {
+		systems = append(systems, system)
+	}
+	sort.Strings(systems)
+	return systems
+}
+
+/*
+readUTM reads a UTM coordinate, both readings are returned when the letter S is a latitude band or the hemisphere.
+
+The band is the notation of String and more common than the hemisphere, which is given less confidence.
+*/
+func readUTM(text string) ([]Candidate, error) {
+
+	readings, err := parseUTMReadings(text)
+	if err != nil {
+		return nil, err
+	}
+	if len(readings) == 1 {
+		return []Candidate{{Coordinate: readings[0], System: SystemUTM, Confidence: 0.9}}, nil
+	}
+	return []Candidate{
+		{Coordinate: readings[0], System: SystemUTM, Confidence: 0.6},
+		{Coordinate: readings[1], System: SystemUTM, Confidence: 0.5},
+	}, nil
+}
+
+/*
+Parse recognises a coordinate in any notation of the registered coordinate systems.
+
+All registered parsers are tried, the candidate with the highest confidence is returned
+and the other recognised candidates are returned as alternatives. A text may have more than one reading
+in the same notation, e.g. "33S 500000 3900000" as UTM band S or the southern hemisphere.
+
+	"56.366667 8.616667"       -> LL
+	"56°22'N 8°37'E"           -> LL
+	"32V 594857.92 6399059.92" -> UTM
+	"33UUB162700"              -> MGRS
+	"33U UB 162 700"           -> USNG
+*/
+func Parse(text string) (Parsed, error) {
+
+	parsers.RLock()
+	systems := make([]string, 0, len(parsers.bySystem))
+	for system := range parsers.bySystem {
+		systems = append(systems, system)
+	}
+	sort.Strings(systems)
+	candidates := make([]Candidate, 0, len(systems))
+	for _, system := range systems {
+		readings, err := parsers.bySystem[system](text)
+		if err != nil {
+			continue
+		}
+		for _, candidate := range readings {
+			if candidate.Confidence > 0 {
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+	parsers.RUnlock()
+
+	if len(candidates) == 0 {
+		return Parsed{}, fmt.Errorf("unrecognised coordinate, text = %s", text)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Confidence > candidates[j].Confidence
+	})
+	return Parsed{Candidate: candidates[0], Alternatives: candidates[1:]}, nil
+}
+
+// Ambiguous reports whether more than one notation matched the text
+func (parsed Parsed) Ambiguous() bool {
+	return len(parsed.Alternatives) > 0
+}
+
+// mgrsPattern matches zone number, latitude band, 100-km grid letters and an even number of digits
+var mgrsPattern = regexp.MustCompile(`^\d{1,2}[C-HJ-NP-X][A-HJ-NP-Z][A-HJ-NP-V](\d\d){0,5}$`)
+
+/*
+parseMGRS parses a MGRS reference without spaces, e.g. 33UUB162700.
+*/
+func parseMGRS(text string) (Coordinate, float64, error) {
+
+	mgrs := MGRS(strings.ToUpper(strings.TrimSpace(text)))
+	if !mgrsPattern.MatchString(string(mgrs)) {
+		return nil, 0, fmt.Errorf("bad conversion, mgrs = %s", text)
+	}
+	if _, err := mgrs.ToLL(); err != nil {
+		return nil, 0, err
+	}
+	return mgrs, 1.0, nil
+}
+
+// usngPattern matches zone number and latitude band, 100-km grid letters, easting and northing separated by spaces
+var usngPattern = regexp.MustCompile(`^\d{1,2}[A-Z] [A-Z]{2}( \d+ \d+)?$`)
+
+/*
+parseUSNG parses a USNG reference, e.g. 33U UB 162 700.
+
+A MGRS reference with spaces elsewhere is recognised with less confidence.
+*/
+func parseUSNG(text string) (Coordinate, float64, error) {
+
+	normalized := strings.Join(strings.Fields(strings.ToUpper(text)), " ")
+	if !strings.Contains(normalized, " ") {
+		return nil, 0, fmt.Errorf("bad conversion, usng = %s", text)
+	}
+	mgrs := MGRS(strings.ReplaceAll(normalized, " ", ""))
+	if !mgrsPattern.MatchString(string(mgrs)) {
+		return nil, 0, fmt.Errorf("bad conversion, usng = %s", text)
+	}
+	if _, err := mgrs.ToLL(); err != nil {
+		return nil, 0, err
+	}
+	if usngPattern.MatchString(normalized) {
+		return mgrs.ToUSNG(), 1.0, nil
+	}
+	return mgrs.ToUSNG(), 0.8, nil
+}
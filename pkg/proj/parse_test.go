@@ -0,0 +1,97 @@
+package proj
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+
+	var tests = []struct {
+		text       string  // in
+		coordinate string  // out
+		system     string  // out
+		confidence float64 // out
+		err        error   // out
+	}{
+		// positive tests
+		{"56.366667 8.616667", "56.366667 8.616667", SystemLL, 0.8, nil},
+		{"56°22'N 8°37'E", "56.366667 8.616667", SystemLL, 1.0, nil},
+		{"32V 594857.92 6399059.92", "32V 594857.92 6399059.92", SystemUTM, 0.9, nil},
+		{"32N 594857 6399059", "32N 594857.00 6399059.00", SystemUTM, 0.9, nil},
+		{"33UUB162700", "33UUB162700", SystemMGRS, 1.0, nil},
+		{"33uub162700", "33UUB162700", SystemMGRS, 1.0, nil},
+		{"33U UB 162 700", "33U UB 162 700", SystemUSNG, 1.0, nil},
+		{"33UUB 162700", "33U UB 162 700", SystemUSNG, 0.8, nil},
+		{"geo:55.6764,12.5683;u=10", "geo:55.6764,12.5683;u=10", SystemGeoURI, 1.0, nil},
+		// negative tests
+		{"Skagen", "<nil>", "", 0, fmt.Errorf("unrecognised coordinate, text = Skagen")},
+		{"33UUB16270", "<nil>", "", 0, fmt.Errorf("unrecognised coordinate, text = 33UUB16270")},
+	}
+
+	for _, test := range tests {
+		parsed, err := Parse(test.text)
+		function := fmt.Sprintf("Parse(%q)", test.text)
+		got := fmt.Sprintf("%v %s %.1f %t %v", parsed.Coordinate, parsed.System, parsed.Confidence, parsed.Ambiguous(), err)
+		want := fmt.Sprintf("%s %s %.1f %t %v", test.coordinate, test.system, test.confidence, false, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParse_Ambiguous(t *testing.T) {
+
+	// a notation of two plain numbers competing with latitude longitude
+	RegisterParser("PAIR", func(text string) (Coordinate, float64, error) {
+		ll, labelled, err := parseLL(text)
+		if err != nil || labelled {
+			return nil, 0, fmt.Errorf("bad conversion, pair = %s", text)
+		}
+		return testPoint{ll: ll}, 0.5, nil
+	})
+	defer func() {
+		parsers.Lock()
+		delete(parsers.bySystem, "PAIR")
+		parsers.Unlock()
+	}()
+
+	parsed, err := Parse("56.366667 8.616667")
+	function := "Parse(\"56.366667 8.616667\")"
+	got := fmt.Sprintf("%s %t %v %v", parsed.System, parsed.Ambiguous(), parsed.Alternatives, err)
+	want := "LL true [{TEST 56.366667 8.616667 PAIR 0.5}] <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", function, got, want)
+	}
+}
+
+func TestParse_UTMBandOrHemisphere(t *testing.T) {
+
+	var tests = []struct {
+		text         string  // in
+		coordinate   string  // out
+		confidence   float64 // out
+		alternatives string  // out
+	}{
+		{"33S 500000 3900000", "33S 500000.00 3900000.00", 0.6, "[{33S 500000.00 3900000.00 UTM 0.5}]"},
+		{"32S 500000 9000000", "32S 500000.00 9000000.00", 0.9, "[]"},
+	}
+
+	for _, test := range tests {
+		parsed, err := Parse(test.text)
+		function := fmt.Sprintf("Parse(%q)", test.text)
+		got := fmt.Sprintf("%v %.1f %v %v", parsed.Coordinate, parsed.Confidence, parsed.Alternatives, err)
+		want := fmt.Sprintf("%s %.1f %s <nil>", test.coordinate, test.confidence, test.alternatives)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+
+	// the alternative is the southern hemisphere
+	parsed, _ := Parse("33S 500000 3900000")
+	band, hemisphere := parsed.Coordinate.(UTM), parsed.Alternatives[0].Coordinate.(UTM)
+	got := fmt.Sprintf("%c %s", band.ZoneLetter, hemisphere.Hemisphere)
+	if got != "S S" || hemisphere.ZoneLetter != 0 || band.Hemisphere != 0 {
+		t.Errorf("\n%s -> %#v %#v\n", "Parse(\"33S 500000 3900000\")", band, hemisphere)
+	}
+}
@@ -0,0 +1,162 @@
+package proj
+
+import (
+	"fmt"
+	"math"
+)
+
+// PolarStereographic defines a polar stereographic projection by its ellipsoid and parameters
+/*
+The projection is centred at the pole of the hemisphere, the meridian Lon0 runs from the pole towards the bottom of the map
+in the north and towards the top in the south. Unlike UTM it covers the polar regions above 84°N and below 80°S.
+
+The scale is given in one of two ways
+
+  - variant A (EPSG method 9810): the scale factor K0 at the pole, e.g. UPS with 0.994, see UPSProjection
+  - variant B (EPSG method 9829): the latitude of true scale LatTS, e.g. EPSG:3413 with 70°N, see NSIDCSeaIceNorth
+
+When LatTS is not zero the projection is variant B and K0 is not used.
+
+See also
+  - https://en.wikipedia.org/wiki/Universal_polar_stereographic_coordinate_system
+  - IOGP, Geomatics Guidance Note 7 part 2, Coordinate Conversions and Transformations including Formulas
+*/
+type PolarStereographic struct {
+	Ellipsoid     Ellipsoid
+	Hemisphere    Hemisphere // the pole of the projection
+	Lon0          float64    // longitude of origin in degrees
+	LatTS         float64    // latitude of true scale in degrees (variant B)
+	K0            float64    // scale factor at the pole (variant A)
+	FalseEasting  float64    // meters
+	FalseNorthing float64    // meters
+}
+
+// NSIDCSeaIceNorth is the projection of the NSIDC Sea Ice Polar Stereographic North grid (EPSG:3413) used for Greenland and the Arctic
+var NSIDCSeaIceNorth = PolarStereographic{Ellipsoid: WGS84, Hemisphere: North, Lon0: -45, LatTS: 70}
+
+/*
+UPSProjection returns the polar stereographic projection of the Universal Polar Stereographic system (UPS) on WGS84.
+
+	For the north pole: UPSProjection(North) -> scale factor 0.994, false easting and northing 2000000
+*/
+func UPSProjection(hemisphere Hemisphere) (PolarStereographic, error) {
+
+	if err := hemisphere.validate(); err != nil {
+		return PolarStereographic{}, err
+	}
+	return PolarStereographic{
+		Ellipsoid:     WGS84,
+		Hemisphere:    hemisphere,
+		K0:            0.994,
+		FalseEasting:  2000000,
+		FalseNorthing: 2000000,
+	}, nil
+}
+
+// stereographic holds the constants of a polar stereographic projection
+type stereographic struct {
+	e    float64 // first eccentricity
+	sign float64 // 1 at the north pole, -1 at the south pole
+	c    float64 // radius of the parallel with t = 1, 2 a k0 / sqrt((1+e)^(1+e) (1-e)^(1-e))
+}
+
+// stereographic returns the constants of the projection, in the south the formulas of the north apply to the mirrored latitude
+func (ps PolarStereographic) stereographic() (stereographic, error) {
+
+	if err := ps.Hemisphere.validate(); err != nil {
+		return stereographic{}, err
+	}
+	k := stereographic{e: math.Sqrt(ps.Ellipsoid.EccSquared()), sign: 1}
+	if ps.Hemisphere == South {
+		k.sign = -1
+	}
+	e := k.e
+	root := math.Sqrt(math.Pow(1+e, 1+e) * math.Pow(1-e, 1-e))
+
+	if ps.LatTS == 0 {
+		if ps.K0 <= 0 {
+			return stereographic{}, fmt.Errorf("invalid scale factor, k0 = %v", ps.K0)
+		}
+		k.c = 2 * ps.Ellipsoid.A * ps.K0 / root
+		return k, nil
+	}
+
+	latTS := k.sign * ps.LatTS
+	if latTS <= 0 || latTS > 90 {
+		return stereographic{}, fmt.Errorf("latitude of true scale not in hemisphere %s, lat ts = %v", ps.Hemisphere, ps.LatTS)
+	}
+	if latTS == 90 {
+		k.c = 2 * ps.Ellipsoid.A / root
+		return k, nil
+	}
+	// the scale is true on the parallel, where ρ = a mF
+	phiF := degToRad(latTS)
+	k.c = ps.Ellipsoid.A * coneM(phiF, e) / coneT(phiF, e)
+	return k, nil
+}
+
+/*
+Forward projects latitude longitude to easting northing.
+
+The pole of the other hemisphere can not be projected.
+
+	For the city of Nuuk in EPSG:3413: "64.180000 -51.720000" -> -332729.08 -2823880.46
+*/
+func (ps PolarStereographic) Forward(ll LL) (easting, northing float64, err error) {
+
+	if _, err := ll.ToLL(); err != nil {
+		return 0, 0, err
+	}
+	k, err := ps.stereographic()
+	if err != nil {
+		return 0, 0, err
+	}
+	if k.sign*ll.Lat == -90 {
+		return 0, 0, fmt.Errorf("pole opposite the pole of the projection, lat = %v", ll.Lat)
+	}
+
+	rho := 0.0
+	if k.sign*ll.Lat < 90 {
+		rho = k.c * coneT(degToRad(k.sign*ll.Lat), k.e)
+	}
+	dLon := degToRad(ll.Lon - ps.Lon0)
+
+	easting = ps.FalseEasting + rho*math.Sin(dLon)
+	northing = ps.FalseNorthing - k.sign*rho*math.Cos(dLon)
+	return easting, northing, nil
+}
+
+/*
+Inverse projects easting northing to latitude longitude.
+
+	For the city of Nuuk in EPSG:3413: -332729.08 -2823880.46 -> "64.180000 -51.720000"
+*/
+func (ps PolarStereographic) Inverse(easting, northing float64) (LL, error) {
+
+	k, err := ps.stereographic()
+	if err != nil {
+		return LL{}, err
+	}
+	x := easting - ps.FalseEasting
+	y := -k.sign * (northing - ps.FalseNorthing)
+	rho := math.Hypot(x, y)
+	if rho == 0 {
+		return LL{Lat: k.sign * 90, Lon: ps.Lon0}.ToLL()
+	}
+
+	// solve the latitude of t by fixed point iteration
+	t := rho / k.c
+	phi := math.Pi/2 - 2*math.Atan(t)
+	for range 15 {
+		sinPhi := math.Sin(phi)
+		next := math.Pi/2 - 2*math.Atan(t*math.Pow((1-k.e*sinPhi)/(1+k.e*sinPhi), k.e/2))
+		if math.Abs(next-phi) < 1e-14 {
+			phi = next
+			break
+		}
+		phi = next
+	}
+
+	lon := math.Remainder(ps.Lon0+radToDeg(math.Atan2(x, y)), 360)
+	return LL{Lat: k.sign * radToDeg(phi), Lon: lon}.ToLL()
+}
@@ -0,0 +1,108 @@
+package proj
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestPolarStereographic_Forward(t *testing.T) {
+
+	upsNorth, _ := UPSProjection(North)
+	upsSouth, _ := UPSProjection(South)
+	// the example of variant B of the EPSG guidance note 7-2, Australian Antarctic Polar Stereographic
+	antarctic := PolarStereographic{Ellipsoid: WGS84, Hemisphere: South, Lon0: 70, LatTS: -71, FalseEasting: 6000000, FalseNorthing: 6000000}
+
+	var tests = []struct {
+		ps  PolarStereographic // in
+		ll  LL                 // in
+		en  string             // out
+		err error              // out
+	}{
+		// positive tests
+		// the example of variant A of the EPSG guidance note 7-2
+		{upsNorth, LL{Lat: 73, Lon: 44}, "3320416.747 632668.431", nil},
+		{antarctic, LL{Lat: -75, Lon: 120}, "7255380.793 7053389.561", nil},
+		{upsNorth, LL{Lat: 89.9, Lon: 179}, "2000193.763 2011100.693", nil},
+		{upsSouth, LL{Lat: -85, Lon: -120}, "1518959.788 1722271.304", nil},
+		{upsSouth, LL{Lat: -90, Lon: 0}, "2000000.000 2000000.000", nil},
+		{NSIDCSeaIceNorth, LL{Lat: 64.18, Lon: -51.72}, "-332729.077 -2823880.459", nil},
+		{NSIDCSeaIceNorth, LL{Lat: 78.92, Lon: 11.93}, "1008848.558 -656907.647", nil},
+		{NSIDCSeaIceNorth, LL{Lat: 90, Lon: -45}, "0.000 0.000", nil},
+		// negative tests
+		{upsNorth, LL{Lat: -90, Lon: 0}, "0.000 0.000", fmt.Errorf("pole opposite the pole of the projection, lat = -90")},
+		{upsNorth, LL{Lat: 95, Lon: 0}, "0.000 0.000", fmt.Errorf("invalid latitude, lat = 95")},
+		{PolarStereographic{Ellipsoid: WGS84, Hemisphere: North}, LL{Lat: 85, Lon: 0}, "0.000 0.000", fmt.Errorf("invalid scale factor, k0 = 0")},
+		{PolarStereographic{Ellipsoid: WGS84, Hemisphere: North, LatTS: -71}, LL{Lat: 85, Lon: 0}, "0.000 0.000", fmt.Errorf("latitude of true scale not in hemisphere N, lat ts = -71")},
+		{PolarStereographic{Ellipsoid: WGS84, LatTS: 70}, LL{Lat: 85, Lon: 0}, "0.000 0.000", fmt.Errorf("invalid hemisphere, hemisphere = '\\x00'")},
+	}
+
+	for _, test := range tests {
+		easting, northing, err := test.ps.Forward(test.ll)
+		function := fmt.Sprintf("Forward(%s) hemisphere = %s lat ts = %v", test.ll, test.ps.Hemisphere, test.ps.LatTS)
+		got := fmt.Sprintf("%.3f %.3f %v", easting, northing, err)
+		want := fmt.Sprintf("%s %v", test.en, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		ll, err := test.ps.Inverse(easting, northing)
+		function = fmt.Sprintf("Inverse(%.3f, %.3f) hemisphere = %s lat ts = %v", easting, northing, test.ps.Hemisphere, test.ps.LatTS)
+		got = fmt.Sprintf("%.9f %.9f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.9f %.9f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestPolarStereographic_TrueScale validates that the scale of variant B is true on the latitude of true scale
+func TestPolarStereographic_TrueScale(t *testing.T) {
+
+	for _, ps := range []PolarStereographic{
+		NSIDCSeaIceNorth,
+		{Ellipsoid: WGS84, Hemisphere: South, Lon0: 70, LatTS: -71},
+	} {
+		// the length of 0.001° along the parallel is N cos φ dλ
+		phi := degToRad(ps.LatTS)
+		n := WGS84.A / math.Sqrt(1-WGS84.EccSquared()*math.Sin(phi)*math.Sin(phi))
+		want := n * math.Cos(phi) * degToRad(0.001)
+
+		e1, n1, _ := ps.Forward(LL{Lat: ps.LatTS, Lon: ps.Lon0})
+		e2, n2, _ := ps.Forward(LL{Lat: ps.LatTS, Lon: ps.Lon0 + 0.001})
+
+		function := fmt.Sprintf("scale at lat ts = %v", ps.LatTS)
+		got := fmt.Sprintf("%.9f", math.Hypot(e2-e1, n2-n1)/want)
+		if got != "1.000000000" {
+			t.Errorf("\n%s -> %s != 1.000000000\n", function, got)
+		}
+	}
+}
+
+func TestUPSProjection(t *testing.T) {
+
+	var tests = []struct {
+		hemisphere Hemisphere // in
+		ps         string     // out
+		err        error      // out
+	}{
+		// positive tests
+		{North, "N 0.994 2000000 2000000", nil},
+		{South, "S 0.994 2000000 2000000", nil},
+		// negative tests
+		{Hemisphere('X'), "\x00 0 0 0", fmt.Errorf("invalid hemisphere, hemisphere = 'X'")},
+	}
+
+	for _, test := range tests {
+		ps, err := UPSProjection(test.hemisphere)
+		function := fmt.Sprintf("UPSProjection(%s)", test.hemisphere)
+		got := fmt.Sprintf("%s %v %.0f %.0f %v", ps.Hemisphere, ps.K0, ps.FalseEasting, ps.FalseNorthing, err)
+		want := fmt.Sprintf("%s %v", test.ps, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
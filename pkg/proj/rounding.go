@@ -0,0 +1,33 @@
+package proj
+
+// Rounding defines how easting and northing are reduced to the digits of a MGRS or USNG reference.
+/*
+Different receiving systems expect different conventions
+
+  - Truncate: the reference denotes the south-west corner of the square containing the point (MGRS standard)
+  - Nearest: the reference denotes the square whose corner is nearest to the point
+
+ For the city of Skagen: "32V 594857.92 6399059.92" with accuracy of 1 meter
+	- Truncate: 32VNJ9485799059
+	- Nearest: 32VNJ9485899060
+*/
+type Rounding int
+
+const (
+	Truncate Rounding = iota // truncate to the south-west corner of the square
+	Nearest                  // round to the nearest square corner
+)
+
+/*
+String returns the name of the rounding mode.
+*/
+func (rounding Rounding) String() string {
+	switch rounding {
+	case Truncate:
+		return "truncate"
+	case Nearest:
+		return "nearest"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,80 @@
+// Package systems_test imports every package of the module with a coordinate system, the registrations are
+// kept out of the tests of package proj, which see only the systems of package proj
+package systems_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	_ "github.com/brundtoe/go-geografi/pkg/dk"
+	_ "github.com/brundtoe/go-geografi/pkg/dkn"
+	_ "github.com/brundtoe/go-geografi/pkg/eea"
+	_ "github.com/brundtoe/go-geografi/pkg/gars"
+	_ "github.com/brundtoe/go-geografi/pkg/geohash"
+	_ "github.com/brundtoe/go-geografi/pkg/georef"
+	_ "github.com/brundtoe/go-geografi/pkg/maidenhead"
+	_ "github.com/brundtoe/go-geografi/pkg/nordic"
+	_ "github.com/brundtoe/go-geografi/pkg/olc"
+	_ "github.com/brundtoe/go-geografi/pkg/osgrid"
+	"github.com/brundtoe/go-geografi/pkg/proj"
+	_ "github.com/brundtoe/go-geografi/pkg/webmercator"
+)
+
+// TestParse_AllSystems parses a sample of every coordinate system registered by the packages of the module,
+// the same packages are imported by cmd/proj/parse_coordinate.go
+func TestParse_AllSystems(t *testing.T) {
+
+	var tests = []struct {
+		system string // in
+		text   string // in
+	}{
+		{"BNG", "TQ 30080 80992"},
+		{"DKN", "100m_63986_5941"},
+		{"DKTM", "DKTM2 434563.42 1400117.53"},
+		{"EEA", "1kmE4355N3846"},
+		{"GARS", "382NH31"},
+		{proj.SystemGeoURI, "geo:57.72,10.58"},
+		{"GEOHASH", "u4r82g857g"},
+		{"GEOREF", "NKLN34804320"},
+		{"IRISHGRID", "O 15900 34671"},
+		{"ITM", "ITM 715830.59 734697.36"},
+		{"KP2000", "KP2000J 264356.38 6400290.44"},
+		{"LAEA", "LAEA N 3846595.43 E 4355610.79"},
+		{proj.SystemLL, "57.720000 10.580000"},
+		{"MAIDENHEAD", "JO57gr92ot"},
+		{proj.SystemMGRS, "32VNJ9411598634"},
+		{"NTM", "NTM10 N 968817.76 E 104767.50"},
+		{"PLUSCODE", "9F9GPHCJ+222"},
+		{"RT90", "RT90 N 6411481.23 E 1188794.52"},
+		{"SWEREF99", "SWEREF99 TM N 6406126.92 E 236813.32"},
+		{"TM35FIN", "TM35FIN N 6672126.74 E 385700.42"},
+		{proj.SystemUSNG, "32V NJ 94115 98634"},
+		{proj.SystemUTM, "32V 594115.32 6398634.81"},
+		{"WEBMERCATOR", "WEBMERCATOR 1177760.21 7908726.86"},
+	}
+
+	tested := make([]string, 0, len(tests))
+	for _, test := range tests {
+		tested = append(tested, test.system)
+
+		parsed, err := proj.Parse(test.text)
+		systems := []string{parsed.System}
+		for _, alternative := range parsed.Alternatives {
+			systems = append(systems, alternative.System)
+		}
+		function := fmt.Sprintf("Parse(%q)", test.text)
+		got := fmt.Sprintf("%t %v", slices.Contains(systems, test.system), err)
+		want := "true <nil>"
+		if got != want {
+			t.Errorf("\n%s -> %s != %s, systems = %v\n", function, got, want, systems)
+		}
+	}
+
+	got := strings.Join(proj.ParserSystems(), " ")
+	want := strings.Join(tested, " ")
+	if got != want {
+		t.Errorf("\nParserSystems() -> %s != %s\n", got, want)
+	}
+}
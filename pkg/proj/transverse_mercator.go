@@ -0,0 +1,195 @@
+package proj
+
+import (
+	"fmt"
+	"math"
+)
+
+// TransverseMercator defines a transverse Mercator projection by its ellipsoid and parameters
+/*
+UTM is the transverse Mercator projection with scale factor 0.9996, false easting 500000 and six degree zones,
+other grids use other parameters, e.g. Gauss-Krüger, SWEREF99 TM, ETRS-TM35FIN and the Danish Kp2000 and DKTM.
+
+	For UTM zone 32N: TransverseMercator{Ellipsoid: WGS84, Lon0: 9, K0: 0.9996, FalseEasting: 500000}
+
+The projection uses the Krüger series to sixth order in the third flattening n,
+which is accurate to a few millimeters within 30° of the central meridian.
+
+See also
+  - https://en.wikipedia.org/wiki/Transverse_Mercator_projection
+  - C.F.F. Karney, Transverse Mercator with an accuracy of a few nanometers, J. Geodesy 85 (2011)
+*/
+type TransverseMercator struct {
+	Ellipsoid     Ellipsoid
+	Lon0          float64 // longitude of the central meridian in degrees
+	Lat0          float64 // latitude of origin in degrees
+	K0            float64 // scale factor on the central meridian
+	FalseEasting  float64 // meters
+	FalseNorthing float64 // meters
+}
+
+/*
+UTMProjection returns the transverse Mercator projection of the UTM zone on WGS84.
+
+	For the city of Skagen: UTMProjection(32, North) -> central meridian 9°E
+*/
+func UTMProjection(zoneNumber int, hemisphere Hemisphere) (TransverseMercator, error) {
+
+	if zoneNumber < 1 || zoneNumber > 60 {
+		return TransverseMercator{}, fmt.Errorf("invalid zone number, zone number = %v", zoneNumber)
+	}
+	tm := TransverseMercator{Ellipsoid: WGS84, Lon0: centralMeridian(zoneNumber), K0: 0.9996, FalseEasting: 500000}
+	if hemisphere == South {
+		tm.FalseNorthing = 10000000
+	}
+	return tm, nil
+}
+
+/*
+GaussKruger returns the transverse Mercator projection of a German Gauss-Krüger zone on Bessel 1841 (DHDN).
+
+The zones are three degrees wide with central meridian 3° times the zone number,
+scale factor 1 and the zone number in front of the false easting 500000.
+
+	For Berlin: GaussKruger(4) -> central meridian 12°E, false easting 4500000
+*/
+func GaussKruger(zone int) (TransverseMercator, error) {
+
+	if zone < 1 || zone > 119 {
+		return TransverseMercator{}, fmt.Errorf("invalid zone number, zone number = %v", zone)
+	}
+	return TransverseMercator{
+		Ellipsoid:    Bessel1841,
+		Lon0:         float64(3 * zone),
+		K0:           1,
+		FalseEasting: float64(zone)*1000000 + 500000,
+	}, nil
+}
+
+// krugerSeries holds the series coefficients of an ellipsoid
+type krugerSeries struct {
+	e     float64    // first eccentricity
+	a     float64    // rectifying radius
+	alpha [6]float64 // forward series
+	beta  [6]float64 // inverse series
+}
+
+// series returns the Krüger series coefficients of the ellipsoid
+func (tm TransverseMercator) series() krugerSeries {
+
+	f := tm.Ellipsoid.F()
+	n := f / (2 - f)
+	n2, n3 := n*n, n*n*n
+	n4, n5, n6 := n2*n2, n2*n3, n3*n3
+
+	return krugerSeries{
+		e: math.Sqrt(tm.Ellipsoid.EccSquared()),
+		a: tm.Ellipsoid.A / (1 + n) * (1 + n2/4 + n4/64 + n6/256),
+		alpha: [6]float64{
+			n/2 - 2*n2/3 + 5*n3/16 + 41*n4/180 - 127*n5/288 + 7891*n6/37800,
+			13*n2/48 - 3*n3/5 + 557*n4/1440 + 281*n5/630 - 1983433*n6/1935360,
+			61*n3/240 - 103*n4/140 + 15061*n5/26880 + 167603*n6/181440,
+			49561*n4/161280 - 179*n5/168 + 6601661*n6/7257600,
+			34729*n5/80640 - 3418889*n6/1995840,
+			212378941 * n6 / 319334400,
+		},
+		beta: [6]float64{
+			n/2 - 2*n2/3 + 37*n3/96 - n4/360 - 81*n5/512 + 96199*n6/604800,
+			n2/48 + n3/15 - 437*n4/1440 + 46*n5/105 - 1118711*n6/3870720,
+			17*n3/480 - 37*n4/840 - 209*n5/4480 + 5569*n6/90720,
+			4397*n4/161280 - 11*n5/504 - 830251*n6/7257600,
+			4583*n5/161280 - 108847*n6/3991680,
+			20648693 * n6 / 638668800,
+		},
+	}
+}
+
+// conformal returns the tangent of the conformal latitude of the latitude in radians
+func (k krugerSeries) conformal(lat float64) float64 {
+	tau := math.Tan(lat)
+	sigma := math.Sinh(k.e * math.Atanh(k.e*tau/math.Hypot(1, tau)))
+	return tau*math.Hypot(1, sigma) - sigma*math.Hypot(1, tau)
+}
+
+// gaussKruger projects the conformal sphere to the plane in units of the rectifying radius
+func (k krugerSeries) gaussKruger(lat, dLon float64) (xi, eta float64) {
+
+	tauPrime := k.conformal(lat)
+	xiPrime := math.Atan2(tauPrime, math.Cos(dLon))
+	etaPrime := math.Asinh(math.Sin(dLon) / math.Hypot(tauPrime, math.Cos(dLon)))
+
+	xi, eta = xiPrime, etaPrime
+	for j, alpha := range k.alpha {
+		m := float64(2 * (j + 1))
+		xi += alpha * math.Sin(m*xiPrime) * math.Cosh(m*etaPrime)
+		eta += alpha * math.Cos(m*xiPrime) * math.Sinh(m*etaPrime)
+	}
+	return xi, eta
+}
+
+/*
+Forward projects latitude longitude to easting northing.
+
+The longitude must be less than 90° from the central meridian.
+
+	For the city of Skagen in UTM zone 32N: "57.720000 10.580000" -> 594115.32 6398634.81
+*/
+func (tm TransverseMercator) Forward(ll LL) (easting, northing float64, err error) {
+
+	if _, err := ll.ToLL(); err != nil {
+		return 0, 0, err
+	}
+	dLon := math.Remainder(ll.Lon-tm.Lon0, 360)
+	if math.Abs(dLon) >= 90 {
+		return 0, 0, fmt.Errorf("longitude too far from the central meridian, lon = %v", ll.Lon)
+	}
+
+	k := tm.series()
+	xi, eta := k.gaussKruger(degToRad(ll.Lat), degToRad(dLon))
+	xi0, _ := k.gaussKruger(degToRad(tm.Lat0), 0)
+
+	easting = tm.FalseEasting + tm.K0*k.a*eta
+	northing = tm.FalseNorthing + tm.K0*k.a*(xi-xi0)
+	return easting, northing, nil
+}
+
+/*
+Inverse projects easting northing to latitude longitude.
+
+	For the city of Skagen in UTM zone 32N: 594115.32 6398634.81 -> "57.720000 10.580000"
+*/
+func (tm TransverseMercator) Inverse(easting, northing float64) (LL, error) {
+
+	if tm.K0 <= 0 {
+		return LL{}, fmt.Errorf("invalid scale factor, k0 = %v", tm.K0)
+	}
+	k := tm.series()
+	xi0, _ := k.gaussKruger(degToRad(tm.Lat0), 0)
+	xi := (northing-tm.FalseNorthing)/(tm.K0*k.a) + xi0
+	eta := (easting - tm.FalseEasting) / (tm.K0 * k.a)
+
+	xiPrime, etaPrime := xi, eta
+	for j, beta := range k.beta {
+		m := float64(2 * (j + 1))
+		xiPrime -= beta * math.Sin(m*xi) * math.Cosh(m*eta)
+		etaPrime -= beta * math.Cos(m*xi) * math.Sinh(m*eta)
+	}
+
+	tauPrime := math.Sin(xiPrime) / math.Hypot(math.Sinh(etaPrime), math.Cos(xiPrime))
+	dLon := math.Atan2(math.Sinh(etaPrime), math.Cos(xiPrime))
+
+	// solve the conformal latitude for the latitude by Newton's method
+	e2 := k.e * k.e
+	tau := tauPrime
+	for range 10 {
+		tauI := k.conformal(math.Atan(tau))
+		delta := (tauPrime - tauI) / math.Hypot(1, tauI) * (1 + (1-e2)*tau*tau) / ((1 - e2) * math.Hypot(1, tau))
+		tau += delta
+		if math.Abs(delta) < 1e-14 {
+			break
+		}
+	}
+
+	lon := math.Remainder(tm.Lon0+radToDeg(dLon), 360)
+	return LL{Lat: radToDeg(math.Atan(tau)), Lon: lon}.ToLL()
+}
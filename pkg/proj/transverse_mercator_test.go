@@ -0,0 +1,135 @@
+package proj
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestTransverseMercator_Forward(t *testing.T) {
+
+	utm32, _ := UTMProjection(32, North)
+	utm33s, _ := UTMProjection(33, South)
+	gk4, _ := GaussKruger(4)
+	// on a sphere the projection is x = R atanh(cos φ sin λ), y = R atan(tan φ / cos λ)
+	sphere := TransverseMercator{Ellipsoid: Ellipsoid{Name: "sphere", A: 6371000, InvF: math.Inf(1)}, K0: 1}
+
+	var tests = []struct {
+		tm  TransverseMercator // in
+		ll  LL                 // in
+		en  string             // out
+		err error              // out
+	}{
+		// positive tests
+		{utm32, LL{Lat: 57.72, Lon: 10.58}, "594115.315 6398634.807", nil},
+		{utm32, LL{Lat: 55.1003, Lon: 14.7065}, "863916.929 6120836.517", nil},
+		{utm33s, LL{Lat: -33.9, Lon: 18.4}, "814420.331 6243724.840", nil},
+		{gk4, LL{Lat: 52.52, Lon: 13.405}, "4595356.437 5821533.512", nil},
+		{sphere, LL{Lat: 45, Lon: 30}, "2354077.950 5460405.380", nil},
+		{sphere, LL{Lat: -10, Lon: 60}, "8069393.118 -2160005.944", nil},
+		// negative tests
+		{utm32, LL{Lat: 10, Lon: -100}, "0.000 0.000", fmt.Errorf("longitude too far from the central meridian, lon = -100")},
+		{utm32, LL{Lat: 95, Lon: 10}, "0.000 0.000", fmt.Errorf("invalid latitude, lat = 95")},
+	}
+
+	for _, test := range tests {
+		easting, northing, err := test.tm.Forward(test.ll)
+		function := fmt.Sprintf("Forward(%s) lon0 = %v", test.ll, test.tm.Lon0)
+		got := fmt.Sprintf("%.3f %.3f %v", easting, northing, err)
+		want := fmt.Sprintf("%s %v", test.en, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		ll, err := test.tm.Inverse(easting, northing)
+		function = fmt.Sprintf("Inverse(%.3f, %.3f) lon0 = %v", easting, northing, test.tm.Lon0)
+		got = fmt.Sprintf("%.9f %.9f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.9f %.9f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestTransverseMercator_UTM compares with the UTM projection of LL.ToUTM, which uses a series of lower order
+func TestTransverseMercator_UTM(t *testing.T) {
+
+	for _, ll := range []LL{{Lat: 57.72, Lon: 10.58}, {Lat: 55.1003, Lon: 14.7065}, {Lat: 36.23612346, Lon: 3.5}} {
+		tm, _ := UTMProjection(32, North)
+		easting, northing, _ := tm.Forward(ll)
+		utm := ll.toUTM(32, North)
+
+		function := fmt.Sprintf("Forward(%s)", ll)
+		got := fmt.Sprintf("%.2f %.2f", easting, northing)
+		want := fmt.Sprintf("%.2f %.2f", utm.Easting, utm.Northing)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestTransverseMercator_Antimeridian(t *testing.T) {
+
+	tm := TransverseMercator{Ellipsoid: WGS84, Lon0: 179, K0: 1}
+	easting, northing, err := tm.Forward(LL{Lat: 10, Lon: -179})
+	ll, errInverse := tm.Inverse(easting, northing)
+
+	got := fmt.Sprintf("%.3f %s %v %v", easting, ll, err, errInverse)
+	want := "219320.867 10.000000 -179.000000 <nil> <nil>"
+	if got != want {
+		t.Errorf("\n%s -> %s != %s\n", "Forward(10, -179) lon0 = 179", got, want)
+	}
+}
+
+func TestUTMProjection(t *testing.T) {
+
+	var tests = []struct {
+		zoneNumber int                // in
+		hemisphere Hemisphere         // in
+		tm         TransverseMercator // out
+		err        error              // out
+	}{
+		// positive tests
+		{32, North, TransverseMercator{Ellipsoid: WGS84, Lon0: 9, K0: 0.9996, FalseEasting: 500000}, nil},
+		{1, South, TransverseMercator{Ellipsoid: WGS84, Lon0: -177, K0: 0.9996, FalseEasting: 500000, FalseNorthing: 10000000}, nil},
+		// negative tests
+		{61, North, TransverseMercator{}, fmt.Errorf("invalid zone number, zone number = 61")},
+	}
+
+	for _, test := range tests {
+		tm, err := UTMProjection(test.zoneNumber, test.hemisphere)
+		function := fmt.Sprintf("UTMProjection(%d, %c)", test.zoneNumber, test.hemisphere)
+		got := fmt.Sprintf("%+v %v", tm, err)
+		want := fmt.Sprintf("%+v %v", test.tm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestGaussKruger(t *testing.T) {
+
+	var tests = []struct {
+		zone int                // in
+		tm   TransverseMercator // out
+		err  error              // out
+	}{
+		// positive tests
+		{4, TransverseMercator{Ellipsoid: Bessel1841, Lon0: 12, K0: 1, FalseEasting: 4500000}, nil},
+		// negative tests
+		{0, TransverseMercator{}, fmt.Errorf("invalid zone number, zone number = 0")},
+	}
+
+	for _, test := range tests {
+		tm, err := GaussKruger(test.zone)
+		function := fmt.Sprintf("GaussKruger(%d)", test.zone)
+		got := fmt.Sprintf("%+v %v", tm, err)
+		want := fmt.Sprintf("%+v %v", test.tm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
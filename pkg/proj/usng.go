@@ -1,7 +1,6 @@
 package proj
 
 import (
-	"fmt"
 	"strings"
 )
 
@@ -23,29 +22,31 @@ Se also
 */
 type USNG string
 
+// usngFormat is the layout of zone number and letter, 100-km grid letters, easting and northing separated by spaces
+const usngFormat = "%d%s %s %s %s"
+
 // String returns the stringified USNG object
 func (usng USNG) String() string {
 	return string(usng)
 }
 
+// System returns the name of the coordinate system
+func (usng USNG) System() string {
+	return SystemUSNG
+}
+
 /*
 ToLL converts USNG to latitude longitude.
-*/
-func (usng USNG) ToLL() (LL, int, error) {
 
-	mgrs := usng.ToMGRS()
-
-	utm, accuracy, err := mgrs.ToUTM()
-	if err != nil {
-		return LL{}, 0, fmt.Errorf("error <%v> at mgrs.ToUTM()", err)
-	}
-
-	ll, err := utm.ToLL()
-	if err != nil {
-		return LL{}, 0, fmt.Errorf("error <%v> at utm.ToLL(), utm = %#v", err, utm)
-	}
+The latitude longitude denotes the south-west corner of the square, use Accuracy for the size of the square.
+*/
+func (usng USNG) ToLL() (LL, error) {
+	return usng.ToMGRS().ToLL()
+}
 
-	return ll, accuracy, nil
+// Accuracy returns the accuracy of the USNG reference in meters
+func (usng USNG) Accuracy() (int, error) {
+	return usng.ToMGRS().Accuracy()
 }
 
 // ToMGRS converts USNG to MGRS
@@ -55,8 +56,13 @@ func (usng USNG) ToMGRS() MGRS {
 
 // ToUTM converts USNG to UTM
 func (usng USNG) ToUTM() (UTM, int, error) {
+	return usng.ToUTMLettering(LetteringAA)
+}
+
+// ToUTMLettering converts USNG to UTM using the given lettering scheme
+func (usng USNG) ToUTMLettering(lettering Lettering) (UTM, int, error) {
 	mgrs := usng.ToMGRS()
-	utm, accuracy, err := mgrs.ToUTM()
+	utm, accuracy, err := mgrs.ToUTMLettering(lettering)
 	if err != nil {
 		return UTM{}, 0, err
 	}
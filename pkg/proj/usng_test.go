@@ -69,7 +69,8 @@ func TestUSNG_ToLL(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		ll, accuracy, err := test.usng.ToLL()
+		ll, err := test.usng.ToLL()
+		accuracy, _ := test.usng.Accuracy()
 		function := fmt.Sprintf("mgrs = %s, mgrs.ToLL()", test.usng)
 		got := fmt.Sprintf("%s %d %v", ll, accuracy, err)
 		want := fmt.Sprintf("%s %d %v", test.ll, test.accuracy, test.err)
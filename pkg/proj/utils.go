@@ -3,6 +3,8 @@ package proj
 import (
 	"fmt"
 	"math"
+
+	"github.com/brundtoe/go-geografi/pkg/angle"
 )
 
 // setOriginColumnLetters defines the column letters (for easting) of the lower left value, per set.
@@ -26,7 +28,7 @@ del holds the angle in degrees.
 */
 func degToRad(deg float64) float64 {
 
-	return (deg * (math.Pi / 180.0))
+	return angle.FromDegrees(deg).Radians()
 }
 
 /*
@@ -35,7 +37,47 @@ rad holds the angle in radians.
 */
 func radToDeg(rad float64) float64 {
 
-	return (180.0 * (rad / math.Pi))
+	return angle.FromRadians(rad).Degrees()
+}
+
+/*
+centralMeridian returns the longitude of the central meridian of the UTM zone in degrees.
+*/
+func centralMeridian(zoneNumber int) float64 {
+
+	return float64((zoneNumber-1)*6 - 180 + 3) // +3 puts origin in middle of zone
+}
+
+/*
+convergence calculates the meridian convergence in radians on the WGS84 ellipsoid.
+lat holds the latitude and dLon the longitude difference from the central meridian, both in radians.
+The convergence is positive when grid north is east of true north.
+*/
+func convergence(lat, dLon float64) float64 {
+
+	ep2 := WGS84.EccSquared() / (1 - WGS84.EccSquared())
+	cos := math.Cos(lat)
+	eta2 := ep2 * cos * cos
+	t2 := math.Tan(lat) * math.Tan(lat)
+	A2 := dLon * dLon * cos * cos
+
+	return dLon * math.Sin(lat) * (1 + A2/3*(1+3*eta2+2*eta2*eta2) + A2*A2/15*(2-t2))
+}
+
+/*
+scaleFactor calculates the point scale factor of the UTM projection on the WGS84 ellipsoid.
+lat holds the latitude and dLon the longitude difference from the central meridian, both in radians.
+*/
+func scaleFactor(lat, dLon float64) float64 {
+
+	k0 := 0.9996
+	ep2 := WGS84.EccSquared() / (1 - WGS84.EccSquared())
+	cos := math.Cos(lat)
+	C := ep2 * cos * cos
+	T := math.Tan(lat) * math.Tan(lat)
+	A2 := dLon * dLon * cos * cos
+
+	return k0 * (1 + (1+C)*A2/2 + (5-4*T+42*C+13*C*C-28*ep2)*A2*A2/24 + (61-148*T+16*T*T)*A2*A2*A2/720)
 }
 
 /*
@@ -94,14 +136,15 @@ func getLetterDesignator(lat float64) byte {
 
 /*
 get100kID gets the two-letter 100k designator for a given UTM easting, northing and zone number value.
+lettering holds the lettering scheme for the row letters.
 */
-func get100kID(easting, northing float64, zoneNumber int) string {
+func get100kID(easting, northing float64, zoneNumber int, lettering Lettering) string {
 
 	setParm := get100kSetForZone(zoneNumber)
 	setColumn := int(math.Floor(easting / 100000))
 	setRow := int(math.Floor(northing/100000)) % 20
 
-	return getLetter100kID(setColumn, setRow, setParm)
+	return getLetter100kID(setColumn, setRow, setParm, lettering)
 }
 
 /*
@@ -125,13 +168,14 @@ getLetter100kID gets the two-letter MGRS 100k designator given information trans
 column holds the column index as it relates to the MGRS 100k set spreadsheet, created from the UTM easting. Values are 1-8.
 row holds the row index as it relates to the MGRS 100k set spreadsheet, created from the UTM northing value. Values are from 0-19.
 parm holds the set block, as it relates to the MGRS 100k set spreadsheet, created from the UTM zone. Values are from 1-60.
+lettering holds the lettering scheme for the row letters.
 */
-func getLetter100kID(column, row, parm int) string {
+func getLetter100kID(column, row, parm int, lettering Lettering) string {
 
 	// colOrigin and rowOrigin are the letters at the origin of the set
 	index := parm - 1
 	colOrigin := setOriginColumnLetters[index]
-	rowOrigin := setOriginRowLetters[index]
+	rowOrigin := lettering.rowOrigins()[index]
 
 	// colInt and rowInt are the letters to build to return
 	colInt := int(colOrigin) + column - 1
@@ -221,20 +265,21 @@ func getEastingFromChar(e byte, set int) (float64, error) {
 getNorthingFromChar gets the northing value that should be added to the other, secondary northing value.
 n holds the second letter of the MGRS 100k zone.
 set holds the MGRS table set number, which is dependent on the UTM zone number.
+lettering holds the lettering scheme for the row letters.
 Remark: You have to remember that Northing is determined from the equator, and the vertical
 cycle of letters mean a 2000000 additional northing meters. This happens
 approx. every 18 degrees of latitude. This method does *NOT* count any
 additional northings. You have to figure out how many 2000000 meters need
 to be added for the zone letter of the MGRS coordinate.
 */
-func getNorthingFromChar(n byte, set int) (float64, error) {
+func getNorthingFromChar(n byte, set int, lettering Lettering) (float64, error) {
 
 	if n > 'V' {
 		return 0.0, fmt.Errorf("invalid northing, char = %v", n)
 	}
 
 	// rowOrigin is the letter at the origin of the set for the column
-	curRow := setOriginRowLetters[set-1]
+	curRow := lettering.rowOrigins()[set-1]
 	northingValue := 0.0
 	rewindMarker := false
 
@@ -101,7 +101,7 @@ func TestGet100kID(t *testing.T) {
 		{509102.23, 6110009.07, 33, "WB"},
 	}
 	for _, test := range tests {
-		got := get100kID(test.easting, test.northing, test.zoneNumber)
+		got := get100kID(test.easting, test.northing, test.zoneNumber, LetteringAA)
 		want := test.kmkv
 		function := fmt.Sprintf("get100kID (%f, %f, %d)", test.easting, test.northing, test.zoneNumber)
 		if got != want {
@@ -3,6 +3,11 @@ package proj
 import (
 	"fmt"
 	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/angle"
 )
 
 // UTM defines coordinate in Universal Transverse Mercator
@@ -15,6 +20,13 @@ UTM is a struct composed of utm zone, utm zone letter, easting and northing
 	- Easting: 594857.92 meters
 	- Northing: 6399059.92 meters
 
+The zone is given either as zone and latitude band (zone letter) or as zone and hemisphere.
+When Hemisphere is set the coordinate is in the hemisphere notation and the zone letter is not used.
+
+ For the city og Skagen in hemisphere notation: "32N 594857.92 6399059.92"
+	- Zone number: 32
+	- Hemisphere: N (North)
+
 See also
  - [MGRS]
  - [USNG]
@@ -26,16 +38,316 @@ type UTM struct {
 	ZoneLetter byte
 	Easting    float64
 	Northing   float64
+	Hemisphere Hemisphere
 }
 
 /*
 String returns stringified UTM object.
 
 	For the city of Skagen: "32V 594857.92 6399059.92"
+	In hemisphere notation: "32N 594857.92 6399059.92"
 */
 func (utm UTM) String() string {
 
-	return fmt.Sprintf("%d%c %.2f %.2f", utm.ZoneNumber, utm.ZoneLetter, utm.Easting, utm.Northing)
+	switch {
+	case utm.Hemisphere != 0:
+		return fmt.Sprintf("%d%c %.2f %.2f", utm.ZoneNumber, utm.Hemisphere, utm.Easting, utm.Northing)
+	case utm.ZoneLetter != 0:
+		return fmt.Sprintf("%d%c %.2f %.2f", utm.ZoneNumber, utm.ZoneLetter, utm.Easting, utm.Northing)
+	}
+	// neither latitude band nor hemisphere
+	return fmt.Sprintf("%d %.2f %.2f", utm.ZoneNumber, utm.Easting, utm.Northing)
+}
+
+// System returns the name of the coordinate system
+func (utm UTM) System() string {
+	return SystemUTM
+}
+
+// bandLetters holds the valid latitude band letters from south to north
+const bandLetters = "CDEFGHJKLMNPQRSTUVWX"
+
+/*
+ParseUTMBand parses a UTM coordinate in zone and latitude band notation as formatted by String.
+
+	For the city of Skagen: "32V 594857.92 6399059.92"
+*/
+func ParseUTMBand(s string) (UTM, error) {
+
+	zoneNumber, letter, easting, northing, err := parseUTMFields(s)
+	if err != nil {
+		return UTM{}, err
+	}
+	if !strings.ContainsRune(bandLetters, rune(letter)) {
+		return UTM{}, fmt.Errorf("invalid zone letter, utm = %s", s)
+	}
+	return UTM{ZoneNumber: zoneNumber, ZoneLetter: letter, Easting: easting, Northing: northing}, nil
+}
+
+/*
+ParseUTMHemisphere parses a UTM coordinate in zone and hemisphere notation as formatted by String.
+
+	For the city of Skagen: "32N 594857.92 6399059.92"
+*/
+func ParseUTMHemisphere(s string) (UTM, error) {
+
+	zoneNumber, letter, easting, northing, err := parseUTMFields(s)
+	if err != nil {
+		return UTM{}, err
+	}
+	hemisphere := Hemisphere(letter)
+	if hemisphere.validate() != nil {
+		return UTM{}, fmt.Errorf("invalid hemisphere, utm = %s", s)
+	}
+	return UTM{ZoneNumber: zoneNumber, Hemisphere: hemisphere, Easting: easting, Northing: northing}, nil
+}
+
+/*
+ParseUTM parses a UTM coordinate as typed by users.
+
+The zone is given as zone number and latitude band or hemisphere, before or after easting and northing.
+Easting and northing may be labelled with E and N as prefix or suffix and may have the unit m or km.
+Both point and comma are accepted as decimal separator.
+
+	"32V 594857.92 6399059.92"
+	"32N 594857 6399059"
+	"E594857 N6399059 32V"
+	"32V 594857,92 6399059,92"
+	"32 V 594857.92m E 6399059.92m N"
+
+Easting and northing must be plausible for the zone and band, the easting may be up to 6° from the central meridian
+at the latitude of the band, which allows the wide zones of Norway and Svalbard and data delivered in a neighbouring zone.
+
+The letters N and S are read as latitude band or hemisphere, whichever is plausible. Band N and the northern hemisphere
+are the same coordinate, while band S and the southern hemisphere are not. When both readings of S are plausible the
+latitude band is returned, as it is the notation of String. Use ParseUTMHemisphere for the southern hemisphere,
+[Parse] offers both readings.
+
+	"11S 672349 4011844" -> band S at 36°N, rather than the southern hemisphere at 54°S
+*/
+func ParseUTM(s string) (UTM, error) {
+
+	readings, err := parseUTMReadings(s)
+	if err != nil {
+		return UTM{}, err
+	}
+	return readings[0], nil
+}
+
+/*
+parseUTMReadings parses a UTM coordinate as typed by users and returns the plausible readings of the zone letter,
+the latitude band before the hemisphere.
+*/
+func parseUTMReadings(s string) ([]UTM, error) {
+
+	tokens := utmTokens(s)
+
+	zoneNumber, letter := 0, byte(0)
+	var values []float64
+	easting, northing := math.NaN(), math.NaN()
+	for _, token := range tokens {
+		if m := utmZonePattern.FindStringSubmatch(token); m != nil && zoneNumber == 0 {
+			zoneNumber, _ = strconv.Atoi(m[1])
+			letter = m[2][0]
+			continue
+		}
+		m := utmValuePattern.FindStringSubmatch(token)
+		if m == nil || (m[1] != "" && m[4] != "") {
+			return nil, fmt.Errorf("bad conversion, utm = %s", s)
+		}
+		value, err := strconv.ParseFloat(strings.Replace(m[2], ",", ".", 1), 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad conversion, utm = %s", s)
+		}
+		if m[3] == "KM" {
+			value *= 1000
+		}
+		switch m[1] + m[4] {
+		case "E":
+			easting = value
+		case "N":
+			northing = value
+		default:
+			values = append(values, value)
+		}
+	}
+
+	// unlabelled values are easting and northing in that order
+	for _, value := range values {
+		if math.IsNaN(easting) {
+			easting = value
+		} else if math.IsNaN(northing) {
+			northing = value
+		} else {
+			return nil, fmt.Errorf("bad conversion, utm = %s", s)
+		}
+	}
+	if zoneNumber == 0 || math.IsNaN(easting) || math.IsNaN(northing) {
+		return nil, fmt.Errorf("bad conversion, utm = %s", s)
+	}
+	if zoneNumber > 60 {
+		return nil, fmt.Errorf("invalid zone number, utm = %s", s)
+	}
+	if northing < 0 || northing > 10000000 {
+		return nil, fmt.Errorf("implausible northing, utm = %s", s)
+	}
+	isBand := strings.ContainsRune(bandLetters, rune(letter))
+	hemisphere := Hemisphere(letter)
+	if !isBand && hemisphere.validate() != nil {
+		return nil, fmt.Errorf("invalid zone letter, utm = %s", s)
+	}
+
+	var readings []UTM
+	band := UTM{ZoneNumber: zoneNumber, ZoneLetter: letter, Easting: easting, Northing: northing}
+	err := band.plausible(s)
+	if err == nil {
+		readings = append(readings, band)
+	}
+	// band N is in the northern hemisphere, both readings are the same coordinate
+	if hemisphere.validate() == nil && !(hemisphere == North && len(readings) > 0) {
+		utm := UTM{ZoneNumber: zoneNumber, Hemisphere: hemisphere, Easting: easting, Northing: northing}
+		if err = utm.plausible(s); err == nil {
+			readings = append(readings, utm)
+		}
+	}
+	if len(readings) == 0 {
+		return nil, err
+	}
+	return readings, nil
+}
+
+var (
+	utmZonePattern  = regexp.MustCompile(`^(\d{1,2})([A-Z])$`)
+	utmValuePattern = regexp.MustCompile(`^(E|N)?(-?\d+(?:[.,]\d+)?)(M|KM)?(E|N)?$`)
+)
+
+/*
+utmTokens splits the text in tokens for the zone and each value with its labels and unit.
+
+	"32 V 594857,92 m E, 6399059,92 m N" -> "32V", "594857,92ME", "6399059,92MN"
+*/
+func utmTokens(s string) []string {
+
+	text := strings.ToUpper(s)
+	// a comma or semicolon followed by space separates values, otherwise a comma is a decimal separator
+	text = strings.NewReplacer(", ", " ", "; ", " ", ";", " ").Replace(text)
+	fields := strings.Fields(strings.TrimRight(text, ","))
+
+	var tokens []string
+	for i := 0; i < len(fields); i++ {
+		field := strings.TrimRight(fields[i], ",")
+		switch {
+		case len(tokens) > 0 && utmDigits(tokens[len(tokens)-1]) && len(field) == 1 && field[0] >= 'A' && field[0] <= 'Z' && len(tokens[len(tokens)-1]) <= 2:
+			// zone number and letter separated by space
+			tokens[len(tokens)-1] += field
+		case field == "M" || field == "KM" || ((field == "E" || field == "N") && len(tokens) > 0 && utmUnlabelled(tokens[len(tokens)-1])):
+			// unit or label after the value
+			if len(tokens) == 0 {
+				tokens = append(tokens, field)
+			} else {
+				tokens[len(tokens)-1] += field
+			}
+		case (field == "E" || field == "N") && i+1 < len(fields):
+			// label before the value
+			fields[i+1] = field + fields[i+1]
+		default:
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+// utmDigits reports whether the token only holds digits
+func utmDigits(token string) bool {
+	return token != "" && strings.Trim(token, "0123456789") == ""
+}
+
+// utmUnlabelled reports whether the token is a value without E or N label
+func utmUnlabelled(token string) bool {
+	m := utmValuePattern.FindStringSubmatch(token)
+	return m != nil && m[1] == "" && m[4] == "" && len(token) > 2
+}
+
+// maxOffset is the largest plausible distance in degrees from the central meridian of a zone
+const maxOffset = 6.0
+
+/*
+plausible returns an error when easting and northing are implausible for the zone and the latitude band or hemisphere.
+
+The latitude must be within the latitude band with a margin of half a degree, and the easting within maxOffset
+of the central meridian at the latitude of the band closest to the equator, where the band is widest.
+*/
+func (utm UTM) plausible(s string) error {
+
+	ll, err := utm.ToLL()
+	if err != nil {
+		return err
+	}
+	letter := utm.ZoneLetter
+	if utm.Hemisphere != 0 {
+		if (utm.Hemisphere == South) != (ll.Lat < 0) {
+			return fmt.Errorf("northing not in hemisphere %s, utm = %s", utm.Hemisphere, s)
+		}
+		letter = getLetterDesignator(ll.Lat)
+	}
+	index := strings.IndexByte(bandLetters, letter)
+	if index < 0 {
+		return fmt.Errorf("polar regions below 80°S and above 84°N not supported, utm = %s", s)
+	}
+	south := float64(-80 + 8*index)
+	north := south + 8
+	if letter == 'X' {
+		north = 84
+	}
+	if ll.Lat < south-0.5 || ll.Lat > north+0.5 {
+		return fmt.Errorf("northing not in latitude band %c, utm = %s", letter, s)
+	}
+
+	widest := south
+	if north <= 0 {
+		widest = north
+	}
+	tm, err := UTMProjection(utm.ZoneNumber, North)
+	if err != nil {
+		return err
+	}
+	east, _, err := tm.Forward(LL{Lat: widest, Lon: tm.Lon0 + maxOffset})
+	if err != nil {
+		return err
+	}
+	if math.Abs(utm.Easting-tm.FalseEasting) > east-tm.FalseEasting {
+		return fmt.Errorf("implausible easting, utm = %s", s)
+	}
+	return nil
+}
+
+/*
+parseUTMFields splits "32V 594857.92 6399059.92" into zone number, letter, easting and northing.
+*/
+func parseUTMFields(s string) (int, byte, float64, float64, error) {
+
+	fields := strings.Fields(strings.ToUpper(s))
+	if len(fields) != 3 || len(fields[0]) < 2 {
+		return 0, 0, 0, 0, fmt.Errorf("bad conversion, utm = %s", s)
+	}
+
+	zone := fields[0]
+	letter := zone[len(zone)-1]
+	zoneNumber, err := strconv.Atoi(zone[:len(zone)-1])
+	if err != nil || zoneNumber < 1 || zoneNumber > 60 {
+		return 0, 0, 0, 0, fmt.Errorf("invalid zone number, utm = %s", s)
+	}
+
+	easting, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("error <%v> at strconv.ParseFloat(), easting string = %v", err, fields[1])
+	}
+	northing, err := strconv.ParseFloat(fields[2], 64)
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("error <%v> at strconv.ParseFloat(), northing string = %v", err, fields[2])
+	}
+
+	return zoneNumber, letter, easting, northing, nil
 }
 
 /*
@@ -44,7 +356,6 @@ ToLL converts UTM to laitude longitude.
 func (utm UTM) ToLL() (LL, error) {
 
 	zoneNumber := utm.ZoneNumber
-	zoneLetter := utm.ZoneLetter
 	UTMEasting := utm.Easting
 	UTMNorthing := utm.Northing
 
@@ -64,7 +375,7 @@ func (utm UTM) ToLL() (LL, error) {
 
 	// We must know somehow if we are in the Northern or Southern hemisphere, this is the only time we use the letter.
 	// So even if the Zone letter isn't exactly correct it should indicate the hemisphere correctly.
-	if zoneLetter < 'N' {
+	if utm.hemisphere() == South {
 		y -= 10000000.0 // remove 10,000,000 meters offset used
 		// for southern hemisphere
 	}
@@ -98,7 +409,94 @@ func (utm UTM) ToLL() (LL, error) {
 	return ll, nil
 }
 
-func (utm UTM) buildGrid(accuracy int, format string) string {
+/*
+ToZone reprojects the UTM coordinate into the target zone, e.g. a neighbouring zone.
+
+Both zones are projected with the Krüger series of [TransverseMercator], a round trip between the zones
+preserves easting and northing to a millimeter. ToLL and LL.ToUTM use a series of lower order,
+see LL.ToUTMZone for the difference.
+
+	For Rønne on Bornholm: "33U 481272.13 6105992.14" -> ToZone(32) -> "32U 863916.93 6120836.52"
+*/
+func (utm UTM) ToZone(targetZone int) (UTM, error) {
+
+	source, err := UTMProjection(utm.ZoneNumber, utm.hemisphere())
+	if err != nil {
+		return UTM{}, err
+	}
+	ll, err := source.Inverse(utm.Easting, utm.Northing)
+	if err != nil {
+		return UTM{}, err
+	}
+	result, err := ll.ToUTMZone(targetZone, utm.hemisphere())
+	if err != nil {
+		return UTM{}, err
+	}
+	if utm.Hemisphere != 0 {
+		return result.ToHemisphere(), nil
+	}
+	return result, nil
+}
+
+/*
+hemisphere returns the hemisphere of the UTM coordinate in either notation.
+*/
+func (utm UTM) hemisphere() Hemisphere {
+	if utm.Hemisphere != 0 {
+		return utm.Hemisphere
+	}
+	if utm.ZoneLetter < 'N' {
+		return South
+	}
+	return North
+}
+
+/*
+ToHemisphere converts the UTM coordinate to the zone and hemisphere notation.
+
+	For the city of Skagen: "32V 594857.92 6399059.92" -> "32N 594857.92 6399059.92"
+*/
+func (utm UTM) ToHemisphere() UTM {
+	utm.Hemisphere = utm.hemisphere()
+	utm.ZoneLetter = 0
+	return utm
+}
+
+/*
+ToBand converts the UTM coordinate to the zone and latitude band notation.
+
+The latitude band is calculated from the latitude of the coordinate.
+
+	For the city of Skagen: "32N 594857.92 6399059.92" -> "32V 594857.92 6399059.92"
+*/
+func (utm UTM) ToBand() (UTM, error) {
+
+	if utm.Hemisphere == 0 {
+		return utm, nil
+	}
+	ll, err := utm.ToLL()
+	if err != nil {
+		return UTM{}, fmt.Errorf("error <%v> at utm.ToLL(), utm = %s", err, utm)
+	}
+	letter := getLetterDesignator(ll.Lat)
+	if letter == 'Z' {
+		return UTM{}, fmt.Errorf("polar regions below 80°S and above 84°N not supported, lat = %.6f", ll.Lat)
+	}
+	utm.ZoneLetter = letter
+	utm.Hemisphere = 0
+	return utm, nil
+}
+
+func (utm UTM) buildGrid(accuracy int, rounding Rounding, lettering Lettering, format string) (string, error) {
+
+	// the grid reference needs the latitude band
+	utm, err := utm.ToBand()
+	if err != nil {
+		return "", err
+	}
+	if !strings.ContainsRune(bandLetters, rune(utm.ZoneLetter)) {
+		return "", fmt.Errorf("invalid zone letter, utm = %s", utm)
+	}
 
 	digits := 0
 	// meters to number of digits
@@ -113,42 +511,179 @@ func (utm UTM) buildGrid(accuracy int, format string) string {
 		digits = 2
 	case 10000:
 		digits = 1
+	case 100000:
+		digits = 0
 	default:
 		digits = 5
 	}
 
+	easting := utm.Easting
+	northing := utm.Northing
+	if rounding == Nearest {
+		// rounding may carry the reference into the neighbouring 100-km square
+		step := math.Pow(10, float64(5-digits))
+		easting = math.Round(easting/step) * step
+		northing = math.Round(northing/step) * step
+	}
+
 	// prepend with leading zeroes
-	seasting := "00000" + fmt.Sprintf("%.0f", math.Floor(utm.Easting))
-	snorthing := "00000" + fmt.Sprintf("%.0f", math.Floor(utm.Northing))
+	seasting := "00000" + fmt.Sprintf("%.0f", math.Floor(easting))
+	snorthing := "00000" + fmt.Sprintf("%.0f", math.Floor(northing))
 
 	east := seasting[len(seasting)-5 : len(seasting)-5+digits]
 	north := snorthing[len(snorthing)-5 : len(snorthing)-5+digits]
-	kmkv := get100kID(utm.Easting, utm.Northing, utm.ZoneNumber)
+	kmkv := get100kID(easting, northing, utm.ZoneNumber, lettering)
 	return fmt.Sprintf(format,
 		utm.ZoneNumber,
 		string(utm.ZoneLetter),
 		kmkv,
 		east,
-		north)
+		north), nil
 
 }
 
 /*
 ToMGRS converts UTM to MGRS
 
+A UTM coordinate in hemisphere notation gets the latitude band from its northing.
+The result is empty when the latitude band cannot be determined, use ToMGRSChecked to get the error.
+
 The accuracy holds the wanted accuracy in meters. Possible values are 1, 10, 100, 1000 or 10.000 meters.
 */
 func (utm UTM) ToMGRS(accuracy int) MGRS {
-	return MGRS(utm.buildGrid(accuracy, "%d%s%s%s%s"))
+	grid, _ := utm.buildGrid(accuracy, Truncate, LetteringAA, mgrsFormat)
+	return MGRS(grid)
+}
+
+/*
+ToMGRSChecked converts UTM to MGRS like ToMGRS, and fails when the latitude band cannot be determined,
+e.g. for a UTM coordinate in hemisphere notation in the polar regions.
+
+	For the city of Skagen: "32N 594857.92 6399059.92" -> "32VNJ9485799059"
+*/
+func (utm UTM) ToMGRSChecked(accuracy int) (MGRS, error) {
+	grid, err := utm.buildGrid(accuracy, Truncate, LetteringAA, mgrsFormat)
+	return MGRS(grid), err
+}
+
+/*
+ToMGRSRounded converts UTM to MGRS using the given rounding mode.
+
+The accuracy holds the wanted accuracy in meters. Possible values are 1, 10, 100, 1000, 10.000 or 100.000 meters.
+*/
+func (utm UTM) ToMGRSRounded(accuracy int, rounding Rounding) MGRS {
+	grid, _ := utm.buildGrid(accuracy, rounding, LetteringAA, mgrsFormat)
+	return MGRS(grid)
+}
 
+/*
+ToMGRSLettering converts UTM to MGRS using the given lettering scheme.
+
+The accuracy holds the wanted accuracy in meters. Possible values are 1, 10, 100, 1000, 10.000 or 100.000 meters.
+Use the lettering of the ellipsoid the UTM coordinate is based on, e.g. Bessel1841.Lettering().
+*/
+func (utm UTM) ToMGRSLettering(accuracy int, lettering Lettering) MGRS {
+	grid, _ := utm.buildGrid(accuracy, Truncate, lettering, mgrsFormat)
+	return MGRS(grid)
 }
 
 /*
 ToUSNG converts UTM to USNG.
 
+A UTM coordinate in hemisphere notation gets the latitude band from its northing.
+The result is empty when the latitude band cannot be determined, use ToUSNGChecked to get the error.
+
 The accuracy holds the wanted accuracy in meters. Possible values are 1, 10, 100, 1000 or 10000 meters.
 */
 func (utm UTM) ToUSNG(accuracy int) USNG {
+	grid, _ := utm.buildGrid(accuracy, Truncate, LetteringAA, usngFormat)
+	return USNG(grid)
+}
+
+/*
+ToUSNGChecked converts UTM to USNG like ToUSNG, and fails when the latitude band cannot be determined.
+*/
+func (utm UTM) ToUSNGChecked(accuracy int) (USNG, error) {
+	grid, err := utm.buildGrid(accuracy, Truncate, LetteringAA, usngFormat)
+	return USNG(grid), err
+}
+
+/*
+ToUSNGRounded converts UTM to USNG using the given rounding mode.
+
+The accuracy holds the wanted accuracy in meters. Possible values are 1, 10, 100, 1000, 10000 or 100000 meters.
+*/
+func (utm UTM) ToUSNGRounded(accuracy int, rounding Rounding) USNG {
+	grid, _ := utm.buildGrid(accuracy, rounding, LetteringAA, usngFormat)
+	return USNG(grid)
+}
+
+/*
+ToUSNGLettering converts UTM to USNG using the given lettering scheme.
+
+The accuracy holds the wanted accuracy in meters. Possible values are 1, 10, 100, 1000, 10000 or 100000 meters.
+*/
+func (utm UTM) ToUSNGLettering(accuracy int, lettering Lettering) USNG {
+	grid, _ := utm.buildGrid(accuracy, Truncate, lettering, usngFormat)
+	return USNG(grid)
+}
+
+/*
+Convergence returns the meridian convergence of the UTM coordinate.
+
+The convergence is the angle from true north to grid north, positive when grid north is east of true north.
+
+	For the city of Skagen: "32V 594857.92 6399059.92" -> 1.346639°
+*/
+func (utm UTM) Convergence() (angle.Angle, error) {
 
-	return USNG(utm.buildGrid(accuracy, "%d%s %s %s %s"))
+	ll, err := utm.ToLL()
+	if err != nil {
+		return 0, fmt.Errorf("error <%v> at utm.ToLL(), utm = %s", err, utm)
+	}
+	return ll.Convergence(utm.ZoneNumber)
+}
+
+/*
+ScaleFactor returns the point scale factor of the UTM coordinate.
+
+A distance on the ellipsoid (ground) is the grid distance divided by the scale factor.
+
+	For the city of Skagen: "32V 594857.92 6399059.92" -> 0.999710
+*/
+func (utm UTM) ScaleFactor() (float64, error) {
+
+	ll, err := utm.ToLL()
+	if err != nil {
+		return 0, fmt.Errorf("error <%v> at utm.ToLL(), utm = %s", err, utm)
+	}
+	return ll.ScaleFactor(utm.ZoneNumber)
+}
+
+/*
+GridBearing converts a true bearing at the UTM coordinate to a grid bearing.
+
+The grid bearing is the true bearing minus the convergence, normalized to [0°, 360°).
+*/
+func (utm UTM) GridBearing(trueBearing angle.Angle) (angle.Angle, error) {
+
+	gamma, err := utm.Convergence()
+	if err != nil {
+		return 0, err
+	}
+	return (trueBearing - gamma).Normalize(), nil
+}
+
+/*
+TrueBearing converts a grid bearing at the UTM coordinate to a true bearing.
+
+The true bearing is the grid bearing plus the convergence, normalized to [0°, 360°).
+*/
+func (utm UTM) TrueBearing(gridBearing angle.Angle) (angle.Angle, error) {
+
+	gamma, err := utm.Convergence()
+	if err != nil {
+		return 0, err
+	}
+	return (gridBearing + gamma).Normalize(), nil
 }
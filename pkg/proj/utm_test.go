@@ -3,6 +3,8 @@ package proj
 import (
 	"fmt"
 	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/angle"
 )
 
 func TestUTM_ToLL(t *testing.T) {
@@ -96,3 +98,407 @@ func TestUTM_ToUSNG(t *testing.T) {
 		}
 	}
 }
+
+func TestUTM_ToMGRSChecked(t *testing.T) {
+
+	var tests = []struct {
+		utm      UTM    // in
+		accuracy int    // in
+		mgrs     string // out
+		usng     string // out
+		err      error  // out
+	}{
+		// positive tests
+		{UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 398973, Northing: 5756497}, 1, "32ULC9897356497", "32U LC 98973 56497", nil},
+		{UTM{ZoneNumber: 32, Hemisphere: North, Easting: 398973, Northing: 5756497}, 1, "32ULC9897356497", "32U LC 98973 56497", nil},
+		{UTM{ZoneNumber: 23, Hemisphere: South, Easting: 611733, Northing: 7800614}, 10, "23KPU11730061", "23K PU 1173 0061", nil},
+		// negative tests
+		{UTM{ZoneNumber: 33, Hemisphere: North, Easting: 500000, Northing: 9400000}, 1, "", "",
+			fmt.Errorf("polar regions below 80°S and above 84°N not supported, lat = 84.644100")},
+		{UTM{ZoneNumber: 32, Easting: 398973, Northing: 5756497}, 1, "", "", fmt.Errorf("invalid zone letter, utm = 32 398973.00 5756497.00")},
+	}
+
+	for _, test := range tests {
+		mgrs, err := test.utm.ToMGRSChecked(test.accuracy)
+		function := fmt.Sprintf("utm = %s, ToMGRSChecked(%d)", test.utm, test.accuracy)
+		got := fmt.Sprintf("%s %v", mgrs, err)
+		want := fmt.Sprintf("%s %v", test.mgrs, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+
+		usng, err := test.utm.ToUSNGChecked(test.accuracy)
+		function = fmt.Sprintf("utm = %s, ToUSNGChecked(%d)", test.utm, test.accuracy)
+		got = fmt.Sprintf("%s %v", usng, err)
+		want = fmt.Sprintf("%s %v", test.usng, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+
+		// the unchecked conversion gives the same reference, or an empty reference on error
+		if got := string(test.utm.ToMGRS(test.accuracy)); got != test.mgrs {
+			t.Errorf("\nutm = %s, ToMGRS(%d) -> %s != %s\n", test.utm, test.accuracy, got, test.mgrs)
+		}
+	}
+}
+
+func TestUTM_ToMGRSRounded(t *testing.T) {
+
+	var tests = []struct {
+		utm      UTM      // in
+		accuracy int      // in
+		rounding Rounding // in
+		mgrs     string   // out
+	}{
+		// positive tests
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, 1, Truncate, "32VNJ9485799059"},
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, 1, Nearest, "32VNJ9485899060"},
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, 10, Nearest, "32VNJ94869906"},
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, 100000, Nearest, "32VPK"},
+		// rounding carries the reference into the neighbouring 100-km square
+		{UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 399999.7, Northing: 5799999.6}, 1, Truncate, "32ULC9999999999"},
+		{UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 399999.7, Northing: 5799999.6}, 1, Nearest, "32UMD0000000000"},
+		// negative tests
+		// nothing to do here
+	}
+
+	for _, test := range tests {
+		mgrs := test.utm.ToMGRSRounded(test.accuracy, test.rounding)
+		function := fmt.Sprintf("utm = %s, ToMGRSRounded(%d, %s)", test.utm, test.accuracy, test.rounding)
+		got := string(mgrs)
+		want := test.mgrs
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestUTM_ToUSNGRounded(t *testing.T) {
+
+	var tests = []struct {
+		utm      UTM      // in
+		accuracy int      // in
+		rounding Rounding // in
+		usng     string   // out
+	}{
+		// positive tests
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, 1, Truncate, "32V NJ 94857 99059"},
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, 1, Nearest, "32V NJ 94858 99060"},
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, 1000, Nearest, "32V NJ 95 99"},
+		// negative tests
+		// nothing to do here
+	}
+
+	for _, test := range tests {
+		usng := test.utm.ToUSNGRounded(test.accuracy, test.rounding)
+		function := fmt.Sprintf("utm = %s, ToUSNGRounded(%d, %s)", test.utm, test.accuracy, test.rounding)
+		got := string(usng)
+		want := test.usng
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestUTM_ToMGRSLettering(t *testing.T) {
+
+	var tests = []struct {
+		utm       UTM       // in
+		accuracy  int       // in
+		lettering Lettering // in
+		mgrs      string    // out
+	}{
+		// positive tests
+		{UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 398973, Northing: 5756497}, 1, LetteringAA, "32ULC9897356497"},
+		{UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 398973, Northing: 5756497}, 1, LetteringAL, "32ULN9897356497"},
+		{UTM{ZoneNumber: 33, ZoneLetter: 'U', Easting: 498230, Northing: 6117970}, 10, LetteringAL, "33UVM98231797"},
+		{UTM{ZoneNumber: 31, ZoneLetter: 'U', Easting: 448251, Northing: 5411943}, 1, LetteringAL, "31UDE4825111943"},
+		{UTM{ZoneNumber: 30, ZoneLetter: 'N', Easting: 767993, Northing: 0}, 1, LetteringAL, "30NYR6799300000"},
+		{UTM{ZoneNumber: 23, ZoneLetter: 'K', Easting: 611733, Northing: 7800614}, 1, LetteringAL, "23KPJ1173300614"},
+		// negative tests
+		// nothing to do here
+	}
+
+	for _, test := range tests {
+		mgrs := test.utm.ToMGRSLettering(test.accuracy, test.lettering)
+		function := fmt.Sprintf("utm = %s, ToMGRSLettering(%d, %s)", test.utm, test.accuracy, test.lettering)
+		got := string(mgrs)
+		want := test.mgrs
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestUTM_Convergence(t *testing.T) {
+
+	var tests = []struct {
+		utm         UTM     // in
+		convergence float64 // out, degrees
+		scaleFactor float64 // out
+		err         error   // out
+	}{
+		// positive tests
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, 1.346639, 0.999710, nil},
+		{UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 489092.85, Northing: 6101296.46}, -0.139975, 0.999601, nil},
+		{UTM{ZoneNumber: 30, ZoneLetter: 'N', Easting: 778265.78, Northing: 55318.04}, 0.021830, 1.000559, nil},
+		// negative tests
+		{UTM{ZoneNumber: 132, ZoneLetter: 'U', Easting: 574126, Northing: 5815291}, 0.0, 0.0,
+			fmt.Errorf("error <invalid zone number, zone number = 132> at utm.ToLL(), utm = 132U 574126.00 5815291.00")},
+	}
+
+	for _, test := range tests {
+		gamma, err := test.utm.Convergence()
+		k, _ := test.utm.ScaleFactor()
+		function := fmt.Sprintf("utm = %s, Convergence(), ScaleFactor()", test.utm)
+		got := fmt.Sprintf("%.6f %.6f %v", gamma.Degrees(), k, err)
+		want := fmt.Sprintf("%.6f %.6f %v", test.convergence, test.scaleFactor, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestUTM_GridBearing(t *testing.T) {
+
+	utm := UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}
+
+	var tests = []struct {
+		trueBearing float64 // in, degrees
+		gridBearing float64 // out, degrees
+	}{
+		{90.0, 88.653361},
+		{0.0, 358.653361},
+		{359.0, 357.653361},
+	}
+
+	for _, test := range tests {
+		grid, err := utm.GridBearing(angle.FromDegrees(test.trueBearing))
+		if err != nil {
+			t.Fatalf("utm.GridBearing() -> %v", err)
+		}
+		back, _ := utm.TrueBearing(grid)
+		function := fmt.Sprintf("utm = %s, GridBearing(%f), TrueBearing()", utm, test.trueBearing)
+		got := fmt.Sprintf("%.6f %.6f", grid.Degrees(), back.Degrees())
+		want := fmt.Sprintf("%.6f %.6f", test.gridBearing, test.trueBearing)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestUTM_ToZone(t *testing.T) {
+
+	var tests = []struct {
+		utm        UTM   // in
+		targetZone int   // in
+		result     UTM   // out
+		err        error // out
+	}{
+		// positive tests
+		{UTM{ZoneNumber: 33, ZoneLetter: 'U', Easting: 481272.13, Northing: 6105992.14}, 32, UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 863916.93, Northing: 6120836.52}, nil},
+		{UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 863916.93, Northing: 6120836.52}, 33, UTM{ZoneNumber: 33, ZoneLetter: 'U', Easting: 481272.13, Northing: 6105992.14}, nil},
+		{UTM{ZoneNumber: 23, ZoneLetter: 'K', Easting: 611733.14, Northing: 7800614.37}, 23, UTM{ZoneNumber: 23, ZoneLetter: 'K', Easting: 611733.14, Northing: 7800614.37}, nil},
+		// negative tests
+		{UTM{ZoneNumber: 33, ZoneLetter: 'U', Easting: 481272.13, Northing: 6105992.14}, 61, UTM{}, fmt.Errorf("invalid zone number, zone number = 61")},
+	}
+
+	for _, test := range tests {
+		result, err := test.utm.ToZone(test.targetZone)
+		function := fmt.Sprintf("utm = %s, ToZone(%d)", test.utm, test.targetZone)
+		got := fmt.Sprintf("%s %v", result, err)
+		want := fmt.Sprintf("%s %v", test.result, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+// TestUTM_ToZoneRoundTrip reprojects into a neighbouring zone and back, e.g. Bornholm in EPSG:25832
+func TestUTM_ToZoneRoundTrip(t *testing.T) {
+
+	var tests = []struct {
+		utm        UTM // in
+		targetZone int // in
+	}{
+		{UTM{ZoneNumber: 33, ZoneLetter: 'U', Easting: 481272.13, Northing: 6105992.14}, 32},
+		{UTM{ZoneNumber: 33, ZoneLetter: 'U', Easting: 510000.00, Northing: 6130000.00}, 32},
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, 33},
+		{UTM{ZoneNumber: 23, ZoneLetter: 'K', Easting: 611733.14, Northing: 7800614.37}, 22},
+	}
+
+	for _, test := range tests {
+		result, err := test.utm.ToZone(test.targetZone)
+		back, errBack := result.ToZone(test.utm.ZoneNumber)
+		function := fmt.Sprintf("utm = %s, ToZone(%d).ToZone(%d)", test.utm, test.targetZone, test.utm.ZoneNumber)
+		got := fmt.Sprintf("%.3f %.3f %v %v", back.Easting, back.Northing, err, errBack)
+		want := fmt.Sprintf("%.3f %.3f <nil> <nil>", test.utm.Easting, test.utm.Northing)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestUTM_ToHemisphere(t *testing.T) {
+
+	var tests = []struct {
+		utm        UTM   // in
+		hemisphere UTM   // out
+		band       UTM   // out
+		err        error // out
+	}{
+		// positive tests
+		{UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92},
+			UTM{ZoneNumber: 32, Hemisphere: North, Easting: 594857.92, Northing: 6399059.92},
+			UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, nil},
+		{UTM{ZoneNumber: 23, ZoneLetter: 'K', Easting: 611733.14, Northing: 7800614.37},
+			UTM{ZoneNumber: 23, Hemisphere: South, Easting: 611733.14, Northing: 7800614.37},
+			UTM{ZoneNumber: 23, ZoneLetter: 'K', Easting: 611733.14, Northing: 7800614.37}, nil},
+		// band S is in the northern hemisphere
+		{UTM{ZoneNumber: 11, ZoneLetter: 'S', Easting: 672349, Northing: 4011844},
+			UTM{ZoneNumber: 11, Hemisphere: North, Easting: 672349, Northing: 4011844},
+			UTM{ZoneNumber: 11, ZoneLetter: 'S', Easting: 672349, Northing: 4011844}, nil},
+		// negative tests
+		{UTM{ZoneNumber: 32, ZoneLetter: 'X', Easting: 500000, Northing: 9400000},
+			UTM{ZoneNumber: 32, Hemisphere: North, Easting: 500000, Northing: 9400000},
+			UTM{}, fmt.Errorf("polar regions below 80°S and above 84°N not supported, lat = 84.644100")},
+	}
+
+	for _, test := range tests {
+		hemisphere := test.utm.ToHemisphere()
+		band, err := hemisphere.ToBand()
+		function := fmt.Sprintf("utm = %s, ToHemisphere(), ToBand()", test.utm)
+		got := fmt.Sprintf("%#v %s %v", hemisphere, band, err)
+		want := fmt.Sprintf("%#v %s %v", test.hemisphere, test.band, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestUTM_ToLLHemisphere(t *testing.T) {
+
+	var tests = []struct {
+		utm UTM   // in
+		ll  LL    // out
+		err error // out
+	}{
+		// positive tests
+		{UTM{ZoneNumber: 23, Hemisphere: South, Easting: 611733.14, Northing: 7800614.37}, LL{Lat: -19.887495, Lon: -43.932663}, nil},
+		{UTM{ZoneNumber: 32, Hemisphere: North, Easting: 594857.92, Northing: 6399059.92}, LL{Lat: 57.723661, Lon: 10.592629}, nil},
+		{UTM{ZoneNumber: 56, Hemisphere: South, Easting: 334873, Northing: 6252266}, LL{Lat: -33.857001, Lon: 151.214998}, nil}, // sidney o/h
+		// negative tests
+		{UTM{ZoneNumber: 132, Hemisphere: South, Easting: 574126, Northing: 5815291}, LL{}, fmt.Errorf("invalid zone number, zone number = 132")},
+	}
+
+	for _, test := range tests {
+		ll, err := test.utm.ToLL()
+		function := fmt.Sprintf("utm = %s, ToLL()", test.utm)
+		got := fmt.Sprintf("%s %v", ll, err)
+		want := fmt.Sprintf("%s %v", test.ll, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParseUTMBand(t *testing.T) {
+
+	var tests = []struct {
+		text string // in
+		utm  UTM    // out
+		err  error  // out
+	}{
+		// positive tests
+		{"32V 594857.92 6399059.92", UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, nil},
+		{"33s 672349 4011844", UTM{ZoneNumber: 33, ZoneLetter: 'S', Easting: 672349, Northing: 4011844}, nil},
+		// negative tests
+		{"32Y 594857.92 6399059.92", UTM{}, fmt.Errorf("invalid zone letter, utm = 32Y 594857.92 6399059.92")},
+		{"61V 594857.92 6399059.92", UTM{}, fmt.Errorf("invalid zone number, utm = 61V 594857.92 6399059.92")},
+		{"32V 594857.92", UTM{}, fmt.Errorf("bad conversion, utm = 32V 594857.92")},
+		{"32V 594857,92 6399059.92", UTM{}, fmt.Errorf("error <strconv.ParseFloat: parsing \"594857,92\": invalid syntax> at strconv.ParseFloat(), easting string = 594857,92")},
+	}
+
+	for _, test := range tests {
+		utm, err := ParseUTMBand(test.text)
+		function := fmt.Sprintf("ParseUTMBand(%q)", test.text)
+		got := fmt.Sprintf("%#v %v", utm, err)
+		want := fmt.Sprintf("%#v %v", test.utm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParseUTMHemisphere(t *testing.T) {
+
+	var tests = []struct {
+		text string // in
+		utm  UTM    // out
+		err  error  // out
+	}{
+		// positive tests
+		{"32N 594857.92 6399059.92", UTM{ZoneNumber: 32, Hemisphere: North, Easting: 594857.92, Northing: 6399059.92}, nil},
+		{"23S 611733.14 7800614.37", UTM{ZoneNumber: 23, Hemisphere: South, Easting: 611733.14, Northing: 7800614.37}, nil},
+		// negative tests
+		{"32V 594857.92 6399059.92", UTM{}, fmt.Errorf("invalid hemisphere, utm = 32V 594857.92 6399059.92")},
+		{"N 594857.92 6399059.92", UTM{}, fmt.Errorf("bad conversion, utm = N 594857.92 6399059.92")},
+	}
+
+	for _, test := range tests {
+		utm, err := ParseUTMHemisphere(test.text)
+		function := fmt.Sprintf("ParseUTMHemisphere(%q)", test.text)
+		got := fmt.Sprintf("%#v %v", utm, err)
+		want := fmt.Sprintf("%#v %v", test.utm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParseUTM(t *testing.T) {
+
+	var tests = []struct {
+		text string // in
+		utm  UTM    // out
+		err  error  // out
+	}{
+		// positive tests
+		{"32V 594857.92 6399059.92", UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, nil},
+		{"32N 594857 6399059", UTM{ZoneNumber: 32, Hemisphere: North, Easting: 594857, Northing: 6399059}, nil},
+		{"E594857 N6399059 32V", UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857, Northing: 6399059}, nil},
+		{"N6399059 E594857 32V", UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857, Northing: 6399059}, nil},
+		{"32V 594857,92 6399059,92", UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, nil},
+		{"32V 594857,92, 6399059,92", UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, nil},
+		{"32 V 594857.92m E 6399059.92m N", UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, nil},
+		{"594857.92mE 6399059.92mN 32v", UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, nil},
+		{"32V 594.85792km 6399.05992km", UTM{ZoneNumber: 32, ZoneLetter: 'V', Easting: 594857.92, Northing: 6399059.92}, nil},
+		{"23S 611733.14 7800614.37", UTM{ZoneNumber: 23, Hemisphere: South, Easting: 611733.14, Northing: 7800614.37}, nil},
+		{"32S 500000 9000000", UTM{ZoneNumber: 32, Hemisphere: South, Easting: 500000, Northing: 9000000}, nil},
+		{"32N 500000 500000", UTM{ZoneNumber: 32, ZoneLetter: 'N', Easting: 500000, Northing: 500000}, nil},
+		{"32U 863916.93 6120836.52", UTM{ZoneNumber: 32, ZoneLetter: 'U', Easting: 863916.93, Northing: 6120836.52}, nil}, // Rønne in zone 32
+		{"31N 1100000 500000", UTM{ZoneNumber: 31, ZoneLetter: 'N', Easting: 1100000, Northing: 500000}, nil},
+		{"11S 672349 4011844", UTM{ZoneNumber: 11, ZoneLetter: 'S', Easting: 672349, Northing: 4011844}, nil},
+		{"33S 400000 4000000", UTM{ZoneNumber: 33, ZoneLetter: 'S', Easting: 400000, Northing: 4000000}, nil},
+		// negative tests
+		{"", UTM{}, fmt.Errorf("bad conversion, utm = ")},
+		{"32V 594857 6399059 12", UTM{}, fmt.Errorf("bad conversion, utm = 32V 594857 6399059 12")},
+		{"61V 594857 6399059", UTM{}, fmt.Errorf("invalid zone number, utm = 61V 594857 6399059")},
+		{"32V 59485 6399059", UTM{}, fmt.Errorf("implausible easting, utm = 32V 59485 6399059")},
+		{"32V 594857 16399059", UTM{}, fmt.Errorf("implausible northing, utm = 32V 594857 16399059")},
+		{"32Y 594857 6399059", UTM{}, fmt.Errorf("invalid zone letter, utm = 32Y 594857 6399059")},
+		{"32U 594857 6399059", UTM{}, fmt.Errorf("northing not in latitude band U, utm = 32U 594857 6399059")},
+		{"32V 120000 6399059", UTM{}, fmt.Errorf("implausible easting, utm = 32V 120000 6399059")},
+		{"32S 500000 500000", UTM{}, fmt.Errorf("polar regions below 80°S and above 84°N not supported, utm = 32S 500000 500000")},
+	}
+
+	for _, test := range tests {
+		utm, err := ParseUTM(test.text)
+		function := fmt.Sprintf("ParseUTM(%q)", test.text)
+		got := fmt.Sprintf("%#v %v", utm, err)
+		want := fmt.Sprintf("%#v %v", test.utm, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
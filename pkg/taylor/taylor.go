@@ -1,6 +1,10 @@
 package taylor
 
-import "math"
+import (
+	"math"
+
+	"github.com/brundtoe/go-geografi/pkg/angle"
+)
 
 var smA = 6378137.0
 var smB = 6356752.314
@@ -9,12 +13,12 @@ var UTMScaleFactor = 0.9996
 
 // DegToRad Converts degrees to radians.
 func DegToRad(deg float64) float64 {
-	return deg / 180.0 * math.Pi
+	return angle.FromDegrees(deg).Radians()
 }
 
 // RadToDeg Converts radians to degrees.
 func RadToDeg(rad float64) float64 {
-	return rad / math.Pi * 180.0
+	return angle.FromRadians(rad).Degrees()
 }
 
 // ArcLengthOfMeridian
@@ -0,0 +1,23 @@
+// Package webmercator converts between latitude longitude, Web Mercator and the tiles of web maps
+/*
+Web Mercator (EPSG:3857) is the spherical Mercator projection of WGS84 latitude longitude used by
+OpenStreetMap, Google Maps and Bing Maps. The map is square and ends at latitude ±85.0511°.
+
+At zoom level z the map is divided in 2^z by 2^z tiles of 256 by 256 pixels,
+numbered from x = 0 at longitude -180° and y = 0 at the northern edge (XYZ or slippy map tiles).
+A quadkey is the tile address of Bing Maps with one digit per zoom level.
+
+	p, err := webmercator.Forward(proj.LL{Lat: 57.72, Lon: 10.58})
+	tile, err := webmercator.TileOf(proj.LL{Lat: 57.72, Lon: 10.58}, 12) // "12/2168/1239"
+	quadkey := tile.Quadkey()
+	tiles, err := webmercator.Cover(box, 10)
+	resolution := webmercator.MetersPerPixel(57.72, 12)
+
+The package registers the coordinate system WEBMERCATOR with package proj, see [proj.Convert] and [proj.Parse].
+
+Links:
+  - https://epsg.io/3857
+  - https://wiki.openstreetmap.org/wiki/Slippy_map_tilenames
+  - https://learn.microsoft.com/en-us/bingmaps/articles/bing-maps-tile-system
+*/
+package webmercator
@@ -0,0 +1,237 @@
+package webmercator
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/angle"
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Tile defines a tile of a web map by zoom level and column x and row y
+/*
+	For the city of Skagen: "12/2168/1239"
+*/
+type Tile struct {
+	Z int // zoom level
+	X int // column from longitude -180°
+	Y int // row from the northern edge of the map
+}
+
+// TileSize is the width and height of a tile in pixels
+const TileSize = 256
+
+// MaxZoom is the highest zoom level, where a tile is a few centimeters
+const MaxZoom = 30
+
+// MaxCover is the maximum number of tiles returned by Cover
+const MaxCover = 10000
+
+// tilePattern matches zoom level, x and y of a tile
+var tilePattern = regexp.MustCompile(`^(\d+)/(\d+)/(\d+)$`)
+
+// Box defines a bounding box by its south-west and north-east corners
+/*
+A box crossing the antimeridian has a south-west longitude greater than the north-east longitude.
+*/
+type Box struct {
+	SouthWest proj.LL
+	NorthEast proj.LL
+}
+
+/*
+TileOf returns the tile at the zoom level containing latitude longitude.
+
+Latitudes beyond ±MaxLat are in the northern or southern row of tiles.
+
+	For the city of Skagen: TileOf(proj.LL{Lat: 57.72, Lon: 10.58}, 12) -> "12/2168/1239"
+*/
+func TileOf(ll proj.LL, zoom int) (Tile, error) {
+
+	if zoom < 0 || zoom > MaxZoom {
+		return Tile{}, fmt.Errorf("invalid zoom level, zoom = %d", zoom)
+	}
+	if _, err := ll.ToLL(); err != nil {
+		return Tile{}, err
+	}
+	x, y := tileXY(ll, zoom)
+	return Tile{Z: zoom, X: x, Y: y}, nil
+}
+
+// tileXY returns column and row of the tile containing latitude longitude
+func tileXY(ll proj.LL, zoom int) (x, y int) {
+
+	n := 1 << zoom
+	lat := angle.FromDegrees(math.Max(-MaxLat, math.Min(MaxLat, ll.Lat))).Radians()
+	fx := (ll.Lon + 180) / 360 * float64(n)
+	fy := (1 - math.Asinh(math.Tan(lat))/math.Pi) / 2 * float64(n)
+	x = min(max(int(math.Floor(fx)), 0), n-1)
+	y = min(max(int(math.Floor(fy)), 0), n-1)
+	return x, y
+}
+
+/*
+ParseTile parses a tile written as zoom/x/y, e.g. "12/2168/1239" as in the URL of a tile server.
+*/
+func ParseTile(s string) (Tile, error) {
+
+	m := tilePattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Tile{}, fmt.Errorf("bad conversion, tile = %s", s)
+	}
+	z, errZ := strconv.Atoi(m[1])
+	x, errX := strconv.Atoi(m[2])
+	y, errY := strconv.Atoi(m[3])
+	if errZ != nil || errX != nil || errY != nil {
+		return Tile{}, fmt.Errorf("bad conversion, tile = %s", s)
+	}
+	tile := Tile{Z: z, X: x, Y: y}
+	if !tile.valid() {
+		return Tile{}, fmt.Errorf("invalid tile, tile = %s", s)
+	}
+	return tile, nil
+}
+
+/*
+ParseQuadkey parses a quadkey of Bing Maps, the number of digits is the zoom level.
+
+	For the city of Skagen: "120023131222" -> "12/2168/1239"
+*/
+func ParseQuadkey(quadkey string) (Tile, error) {
+
+	if len(quadkey) > MaxZoom {
+		return Tile{}, fmt.Errorf("bad conversion, quadkey = %s", quadkey)
+	}
+	tile := Tile{Z: len(quadkey)}
+	for i := range len(quadkey) {
+		digit := quadkey[i]
+		if digit < '0' || digit > '3' {
+			return Tile{}, fmt.Errorf("bad conversion, quadkey = %s", quadkey)
+		}
+		mask := 1 << (tile.Z - 1 - i)
+		if (digit-'0')&1 != 0 {
+			tile.X |= mask
+		}
+		if (digit-'0')&2 != 0 {
+			tile.Y |= mask
+		}
+	}
+	return tile, nil
+}
+
+// valid reports whether the zoom level, x and y are within the map
+func (tile Tile) valid() bool {
+	if tile.Z < 0 || tile.Z > MaxZoom {
+		return false
+	}
+	n := 1 << tile.Z
+	return tile.X >= 0 && tile.X < n && tile.Y >= 0 && tile.Y < n
+}
+
+/*
+Quadkey returns the quadkey of Bing Maps with one digit per zoom level, zoom level 0 is the empty quadkey.
+
+	For the city of Skagen: "12/2168/1239" -> "120023131222"
+*/
+func (tile Tile) Quadkey() string {
+
+	var sb strings.Builder
+	for i := tile.Z; i > 0; i-- {
+		mask := 1 << (i - 1)
+		digit := byte('0')
+		if tile.X&mask != 0 {
+			digit++
+		}
+		if tile.Y&mask != 0 {
+			digit += 2
+		}
+		sb.WriteByte(digit)
+	}
+	return sb.String()
+}
+
+/*
+Bounds returns the bounding box of the tile.
+
+	For the city of Skagen: "12/2168/1239" -> {57.704147 10.546875 57.751076 10.634766}
+*/
+func (tile Tile) Bounds() (Box, error) {
+
+	if !tile.valid() {
+		return Box{}, fmt.Errorf("invalid tile, tile = %s", tile)
+	}
+	n := float64(int(1) << tile.Z)
+	lon := func(x int) float64 { return float64(x)/n*360 - 180 }
+	lat := func(y int) float64 {
+		return angle.FromRadians(math.Atan(math.Sinh(math.Pi * (1 - 2*float64(y)/n)))).Degrees()
+	}
+	return Box{
+		SouthWest: proj.LL{Lat: lat(tile.Y + 1), Lon: lon(tile.X)},
+		NorthEast: proj.LL{Lat: lat(tile.Y), Lon: lon(tile.X + 1)},
+	}, nil
+}
+
+/*
+Center returns latitude longitude of the centre of the tile in Web Mercator, which is not midway in latitude.
+*/
+func (tile Tile) Center() (proj.LL, error) {
+
+	if !tile.valid() {
+		return proj.LL{}, fmt.Errorf("invalid tile, tile = %s", tile)
+	}
+	size := 2 * Extent / float64(int(1)<<tile.Z)
+	return Inverse(Point{X: -Extent + (float64(tile.X)+0.5)*size, Y: Extent - (float64(tile.Y)+0.5)*size})
+}
+
+// String returns zoom level, x and y, e.g. "12/2168/1239"
+func (tile Tile) String() string {
+	return fmt.Sprintf("%d/%d/%d", tile.Z, tile.X, tile.Y)
+}
+
+/*
+Cover returns the tiles at the zoom level covering the box.
+
+The tiles are ordered from north to south and from west to east as the rows and columns of the map.
+Latitudes beyond ±MaxLat are covered by the northern or southern row of tiles.
+The number of tiles is limited by MaxCover, choose a lower zoom level for large boxes.
+
+	For Copenhagen: Cover(Box{SouthWest: proj.LL{Lat: 55.6, Lon: 12.45}, NorthEast: proj.LL{Lat: 55.72, Lon: 12.65}}, 11)
+	-> "11/1094/640", "11/1095/640", "11/1094/641", "11/1095/641"
+*/
+func Cover(box Box, zoom int) ([]Tile, error) {
+
+	if zoom < 0 || zoom > MaxZoom {
+		return nil, fmt.Errorf("invalid zoom level, zoom = %d", zoom)
+	}
+	for _, corner := range []proj.LL{box.SouthWest, box.NorthEast} {
+		if _, err := corner.ToLL(); err != nil {
+			return nil, err
+		}
+	}
+	if box.SouthWest.Lat > box.NorthEast.Lat {
+		return nil, fmt.Errorf("south above north, box = %v", box)
+	}
+
+	n := 1 << zoom
+	west, south := tileXY(box.SouthWest, zoom)
+	east, north := tileXY(box.NorthEast, zoom)
+	if box.SouthWest.Lon > box.NorthEast.Lon {
+		east += n
+	}
+
+	count := (south - north + 1) * (east - west + 1)
+	if count > MaxCover {
+		return nil, fmt.Errorf("too many tiles, count = %d", count)
+	}
+
+	cover := make([]Tile, 0, count)
+	for y := north; y <= south; y++ {
+		for x := west; x <= east; x++ {
+			cover = append(cover, Tile{Z: zoom, X: x % n, Y: y})
+		}
+	}
+	return cover, nil
+}
@@ -0,0 +1,150 @@
+package webmercator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestTileOf(t *testing.T) {
+
+	var tests = []struct {
+		ll      proj.LL // in
+		zoom    int     // in
+		tile    string  // out
+		quadkey string  // out
+		err     error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 12, "12/2168/1239", "120023131222", nil},
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 0, "0/0/0", "", nil},
+		{proj.LL{Lat: 0, Lon: 0}, 1, "1/1/1", "3", nil},
+		{proj.LL{Lat: 90, Lon: 180}, 3, "3/7/0", "111", nil},
+		{proj.LL{Lat: -90, Lon: -180}, 3, "3/0/7", "222", nil},
+		// negative tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, 31, "0/0/0", "", fmt.Errorf("invalid zoom level, zoom = 31")},
+		{proj.LL{Lat: 91, Lon: 10.58}, 12, "0/0/0", "", fmt.Errorf("invalid latitude, lat = 91")},
+	}
+
+	for _, test := range tests {
+		tile, err := TileOf(test.ll, test.zoom)
+		function := fmt.Sprintf("TileOf(%s, %d)", test.ll, test.zoom)
+		got := fmt.Sprintf("%s %q %v", tile, tile.Quadkey(), err)
+		want := fmt.Sprintf("%s %q %v", test.tile, test.quadkey, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParseQuadkey(t *testing.T) {
+
+	var tests = []struct {
+		quadkey string // in
+		tile    string // out
+		err     error  // out
+	}{
+		// positive tests
+		{"213", "3/3/5", nil},
+		{"120023131222", "12/2168/1239", nil},
+		{"", "0/0/0", nil},
+		// negative tests
+		{"214", "0/0/0", fmt.Errorf("bad conversion, quadkey = 214")},
+		{"0123012301230123012301230123012", "0/0/0", fmt.Errorf("bad conversion, quadkey = 0123012301230123012301230123012")},
+	}
+
+	for _, test := range tests {
+		tile, err := ParseQuadkey(test.quadkey)
+		function := fmt.Sprintf("ParseQuadkey(%s)", test.quadkey)
+		got := fmt.Sprintf("%s %v", tile, err)
+		want := fmt.Sprintf("%s %v", test.tile, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParseTile(t *testing.T) {
+
+	var tests = []struct {
+		s    string // in
+		tile string // out
+		err  error  // out
+	}{
+		// positive tests
+		{"12/2168/1239", "12/2168/1239", nil},
+		{" 0/0/0 ", "0/0/0", nil},
+		// negative tests
+		{"12/4096/1239", "0/0/0", fmt.Errorf("invalid tile, tile = 12/4096/1239")},
+		{"12/2168", "0/0/0", fmt.Errorf("bad conversion, tile = 12/2168")},
+	}
+
+	for _, test := range tests {
+		tile, err := ParseTile(test.s)
+		function := fmt.Sprintf("ParseTile(%s)", test.s)
+		got := fmt.Sprintf("%s %v", tile, err)
+		want := fmt.Sprintf("%s %v", test.tile, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestTile_Bounds(t *testing.T) {
+
+	var tests = []struct {
+		tile   Tile   // in
+		bounds string // out
+		center string // out
+		err    error  // out
+	}{
+		// positive tests
+		{Tile{Z: 12, X: 2168, Y: 1239}, "{57.704147 10.546875 57.751076 10.634766}", "57.727619 10.590820", nil},
+		{Tile{Z: 0, X: 0, Y: 0}, "{-85.051129 -180.000000 85.051129 180.000000}", "0.000000 0.000000", nil},
+		// negative tests
+		{Tile{Z: 1, X: 2, Y: 0}, "{0.000000 0.000000 0.000000 0.000000}", "0.000000 0.000000", fmt.Errorf("invalid tile, tile = 1/2/0")},
+	}
+
+	for _, test := range tests {
+		bounds, err := test.tile.Bounds()
+		center, _ := test.tile.Center()
+		function := fmt.Sprintf("%s.Bounds()", test.tile)
+		got := fmt.Sprintf("%v %s %v", bounds, center, err)
+		want := fmt.Sprintf("%s %s %v", test.bounds, test.center, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestCover(t *testing.T) {
+
+	var tests = []struct {
+		box   Box    // in
+		zoom  int    // in
+		cover string // out
+		err   error  // out
+	}{
+		// positive tests
+		{Box{SouthWest: proj.LL{Lat: 55.6, Lon: 12.45}, NorthEast: proj.LL{Lat: 55.72, Lon: 12.65}}, 10, "[10/547/320]", nil},
+		{Box{SouthWest: proj.LL{Lat: 55.6, Lon: 12.45}, NorthEast: proj.LL{Lat: 55.72, Lon: 12.65}}, 11,
+			"[11/1094/640 11/1095/640 11/1094/641 11/1095/641]", nil},
+		{Box{SouthWest: proj.LL{Lat: -1, Lon: 179}, NorthEast: proj.LL{Lat: 1, Lon: -179}}, 2, "[2/3/1 2/0/1 2/3/2 2/0/2]", nil},
+		{Box{SouthWest: proj.LL{Lat: -90, Lon: -180}, NorthEast: proj.LL{Lat: 90, Lon: 180}}, 1, "[1/0/0 1/1/0 1/0/1 1/1/1]", nil},
+		// negative tests
+		{Box{SouthWest: proj.LL{Lat: 56, Lon: 8}, NorthEast: proj.LL{Lat: 55, Lon: 9}}, 10, "[]", fmt.Errorf("south above north, box = {56.000000 8.000000 55.000000 9.000000}")},
+		{Box{SouthWest: proj.LL{Lat: 54, Lon: 8}, NorthEast: proj.LL{Lat: 58, Lon: 13}}, 14, "[]", fmt.Errorf("too many tiles, count = 74556")},
+		{Box{SouthWest: proj.LL{Lat: 54, Lon: 8}, NorthEast: proj.LL{Lat: 58, Lon: 13}}, -1, "[]", fmt.Errorf("invalid zoom level, zoom = -1")},
+	}
+
+	for _, test := range tests {
+		cover, err := Cover(test.box, test.zoom)
+		function := fmt.Sprintf("Cover(%v, %d)", test.box, test.zoom)
+		got := fmt.Sprintf("%v %v", cover, err)
+		want := fmt.Sprintf("%s %v", test.cover, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package webmercator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/brundtoe/go-geografi/pkg/angle"
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+// Point defines a Web Mercator coordinate in meters
+/*
+	For the city of Skagen: "WEBMERCATOR 1177760.21 7908726.86"
+*/
+type Point struct {
+	X float64 // meters east of the Greenwich meridian
+	Y float64 // meters north of the equator
+}
+
+// System is the name of the Web Mercator coordinate system
+const System = "WEBMERCATOR"
+
+// Radius is the radius of the sphere, the semi-major axis of WGS84 in meters
+const Radius = 6378137
+
+// MaxLat is the latitude of the northern edge of the map, where the map becomes square
+var MaxLat = angle.FromRadians(math.Atan(math.Sinh(math.Pi))).Degrees()
+
+// Extent is the distance from the centre to the edges of the map in meters
+const Extent = math.Pi * Radius
+
+func init() {
+	proj.Register(System, func(ll proj.LL) (proj.Coordinate, error) { return Forward(ll) })
+	proj.RegisterParser(System, func(text string) (proj.Coordinate, float64, error) {
+		p, err := Parse(text)
+		if err != nil {
+			return nil, 0, err
+		}
+		return p, 1.0, nil
+	})
+}
+
+/*
+Forward projects latitude longitude to Web Mercator.
+
+Latitudes beyond ±MaxLat are outside the map.
+
+	For the city of Skagen: Forward(proj.LL{Lat: 57.72, Lon: 10.58}) -> "WEBMERCATOR 1177760.21 7908726.86"
+*/
+func Forward(ll proj.LL) (Point, error) {
+
+	if _, err := ll.ToLL(); err != nil {
+		return Point{}, err
+	}
+	if math.Abs(ll.Lat) > MaxLat {
+		return Point{}, fmt.Errorf("latitude outside the map, lat = %v", ll.Lat)
+	}
+	lat := angle.FromDegrees(ll.Lat).Radians()
+	return Point{X: Radius * angle.FromDegrees(ll.Lon).Radians(), Y: Radius * math.Log(math.Tan(math.Pi/4+lat/2))}, nil
+}
+
+/*
+Inverse projects Web Mercator to latitude longitude.
+
+	For the city of Skagen: Inverse(Point{X: 1177760.21, Y: 7908726.86}) -> "57.720000 10.580000"
+*/
+func Inverse(p Point) (proj.LL, error) {
+
+	if math.Abs(p.X) > Extent || math.Abs(p.Y) > Extent {
+		return proj.LL{}, fmt.Errorf("outside the map, point = %s", p)
+	}
+	lat := angle.FromRadians(math.Atan(math.Sinh(p.Y / Radius))).Degrees()
+	return proj.LL{Lat: lat, Lon: angle.FromRadians(p.X / Radius).Degrees()}, nil
+}
+
+/*
+Parse parses WEBMERCATOR or EPSG:3857 followed by x and y separated by space or comma.
+
+	For the city of Skagen: "WEBMERCATOR 1177760.21 7908726.86" or "EPSG:3857 1177760.21, 7908726.86"
+*/
+func Parse(s string) (Point, error) {
+
+	fields := strings.Fields(strings.ReplaceAll(strings.ToUpper(s), ",", " "))
+	if len(fields) != 3 || (fields[0] != System && fields[0] != "EPSG:3857") {
+		return Point{}, fmt.Errorf("bad conversion, web mercator = %s", s)
+	}
+	x, errX := strconv.ParseFloat(fields[1], 64)
+	y, errY := strconv.ParseFloat(fields[2], 64)
+	if errX != nil || errY != nil {
+		return Point{}, fmt.Errorf("bad conversion, web mercator = %s", s)
+	}
+	return Point{X: x, Y: y}, nil
+}
+
+// String returns x and y, e.g. "WEBMERCATOR 1177760.21 7908726.86"
+func (p Point) String() string {
+	return fmt.Sprintf("%s %.2f %.2f", System, p.X, p.Y)
+}
+
+// System returns the name of the coordinate system
+func (p Point) System() string {
+	return System
+}
+
+// EPSG returns the EPSG code of Web Mercator
+func (p Point) EPSG() int {
+	return 3857
+}
+
+// ToLL converts Web Mercator to latitude longitude
+func (p Point) ToLL() (proj.LL, error) {
+	return Inverse(p)
+}
+
+/*
+MetersPerPixel returns the ground resolution in meters per pixel at the latitude and zoom level.
+
+The scale of Mercator grows with 1 / cos(lat), a pixel covers fewer meters towards the poles.
+
+	For the city of Skagen: MetersPerPixel(57.72, 12) -> 20.411
+*/
+func MetersPerPixel(lat float64, zoom int) float64 {
+	return 2 * Extent * math.Cos(angle.FromDegrees(lat).Radians()) / (TileSize * math.Pow(2, float64(zoom)))
+}
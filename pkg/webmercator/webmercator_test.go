@@ -0,0 +1,119 @@
+package webmercator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/brundtoe/go-geografi/pkg/proj"
+)
+
+func TestForward(t *testing.T) {
+
+	var tests = []struct {
+		ll    proj.LL // in
+		point string  // out
+		err   error   // out
+	}{
+		// positive tests
+		{proj.LL{Lat: 57.72, Lon: 10.58}, "WEBMERCATOR 1177760.21 7908726.86", nil},
+		{proj.LL{Lat: 0, Lon: 0}, "WEBMERCATOR 0.00 0.00", nil},
+		{proj.LL{Lat: MaxLat, Lon: 180}, "WEBMERCATOR 20037508.34 20037508.34", nil},
+		{proj.LL{Lat: -MaxLat, Lon: -180}, "WEBMERCATOR -20037508.34 -20037508.34", nil},
+		// negative tests
+		{proj.LL{Lat: 86, Lon: 10}, "WEBMERCATOR 0.00 0.00", fmt.Errorf("latitude outside the map, lat = 86")},
+		{proj.LL{Lat: 57.72, Lon: 190}, "WEBMERCATOR 0.00 0.00", fmt.Errorf("invalid longitude, lon = 190")},
+	}
+
+	for _, test := range tests {
+		p, err := Forward(test.ll)
+		function := fmt.Sprintf("Forward(%s)", test.ll)
+		got := fmt.Sprintf("%s %v", p, err)
+		want := fmt.Sprintf("%s %v", test.point, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+		if err != nil {
+			continue
+		}
+
+		ll, err := p.ToLL()
+		function = fmt.Sprintf("%s.ToLL()", p)
+		got = fmt.Sprintf("%.9f %.9f %v", ll.Lat, ll.Lon, err)
+		want = fmt.Sprintf("%.9f %.9f <nil>", test.ll.Lat, test.ll.Lon)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestInverse(t *testing.T) {
+
+	var tests = []struct {
+		point Point  // in
+		ll    string // out
+		err   error  // out
+	}{
+		// positive tests
+		{Point{X: 1177760.21, Y: 7908726.86}, "57.720000 10.580000", nil},
+		// negative tests
+		{Point{X: 0, Y: 20037509}, "0.000000 0.000000", fmt.Errorf("outside the map, point = WEBMERCATOR 0.00 20037509.00")},
+	}
+
+	for _, test := range tests {
+		ll, err := Inverse(test.point)
+		function := fmt.Sprintf("Inverse(%s)", test.point)
+		got := fmt.Sprintf("%s %v", ll, err)
+		want := fmt.Sprintf("%s %v", test.ll, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+
+	var tests = []struct {
+		s     string // in
+		point string // out
+		err   error  // out
+	}{
+		// positive tests
+		{"WEBMERCATOR 1177760.21 7908726.86", "WEBMERCATOR 1177760.21 7908726.86", nil},
+		{"epsg:3857 1177760.21, 7908726.86", "WEBMERCATOR 1177760.21 7908726.86", nil},
+		// negative tests
+		{"EPSG:4326 57.72 10.58", "WEBMERCATOR 0.00 0.00", fmt.Errorf("bad conversion, web mercator = EPSG:4326 57.72 10.58")},
+		{"WEBMERCATOR 1177760.21", "WEBMERCATOR 0.00 0.00", fmt.Errorf("bad conversion, web mercator = WEBMERCATOR 1177760.21")},
+	}
+
+	for _, test := range tests {
+		p, err := Parse(test.s)
+		function := fmt.Sprintf("Parse(%s)", test.s)
+		got := fmt.Sprintf("%s %v", p, err)
+		want := fmt.Sprintf("%s %v", test.point, test.err)
+		if got != want {
+			t.Errorf("\n%s -> %s != %s\n", function, got, want)
+		}
+	}
+}
+
+func TestMetersPerPixel(t *testing.T) {
+
+	var tests = []struct {
+		lat        float64 // in
+		zoom       int     // in
+		resolution string  // out
+	}{
+		{0, 0, "156543.034"},
+		{0, 1, "78271.517"},
+		{57.72, 12, "20.411"},
+		{60, 12, "19.109"},
+	}
+
+	for _, test := range tests {
+		function := fmt.Sprintf("MetersPerPixel(%v, %d)", test.lat, test.zoom)
+		got := fmt.Sprintf("%.3f", MetersPerPixel(test.lat, test.zoom))
+		if got != test.resolution {
+			t.Errorf("\n%s -> %s != %s\n", function, got, test.resolution)
+		}
+	}
+}